@@ -0,0 +1,137 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Evaluator evaluates a single policy against the input
+// and returns the deny messages, if any. Rego is the evaluator
+// used by default, others are mostly useful in tests.
+type Evaluator interface {
+	Evaluate(ctx context.Context, p *Policy, input interface{}) ([]string, error)
+}
+
+// EvaluatorFunc is an adapter to use ordinary functions as evaluators.
+type EvaluatorFunc func(ctx context.Context, p *Policy, input interface{}) ([]string, error)
+
+// Evaluate calls f(ctx, p, input).
+func (f EvaluatorFunc) Evaluate(ctx context.Context, p *Policy, input interface{}) ([]string, error) {
+	return f(ctx, p, input)
+}
+
+// Outcome is the overall result of a policy check.
+type Outcome string
+
+// List of available outcomes.
+const (
+	// Passed means that no enabled policy failed.
+	Passed Outcome = "passed"
+	// AdvisoryFailed means that only advisory policies failed.
+	AdvisoryFailed Outcome = "advisory"
+	// Failed means that a mandatory policy failed.
+	Failed Outcome = "failed"
+)
+
+// PolicyResult is the result of a single policy.
+type PolicyResult struct {
+	Policy   *Policy
+	Messages []string
+}
+
+// Passed reports whether the policy has no deny messages.
+func (r *PolicyResult) Passed() bool {
+	return len(r.Messages) == 0
+}
+
+// Result is the result of the policy group check.
+type Result struct {
+	Outcome Outcome
+	// Overridable is set when the check failed on soft-mandatory policies only.
+	Overridable bool
+	Policies    []*PolicyResult
+}
+
+// Input builds the input document Scalr passes to the policies.
+// Run details (input.tfrun) are not available locally and are left empty.
+func Input(plan []byte) (interface{}, error) {
+	var tfplan interface{}
+	if err := json.Unmarshal(plan, &tfplan); err != nil {
+		return nil, fmt.Errorf("error decoding plan: %v", err)
+	}
+
+	return map[string]interface{}{
+		"tfplan": tfplan,
+		"tfrun":  map[string]interface{}{},
+	}, nil
+}
+
+// Check evaluates the enabled policies of the group against the plan JSON,
+// as produced by `terraform show -json`. If ev is nil, Rego is used.
+func Check(ctx context.Context, group *Group, plan []byte, ev Evaluator) (*Result, error) {
+	input, err := Input(plan)
+	if err != nil {
+		return nil, err
+	}
+	if ev == nil {
+		ev = Rego{}
+	}
+
+	result := &Result{Outcome: Passed}
+	var hard, soft, advisory bool
+	for _, p := range group.Policies {
+		if !p.Enabled {
+			continue
+		}
+
+		messages, err := ev.Evaluate(ctx, p, input)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating policy %q: %v", p.Name, err)
+		}
+
+		pr := &PolicyResult{Policy: p, Messages: messages}
+		result.Policies = append(result.Policies, pr)
+		if pr.Passed() {
+			continue
+		}
+
+		switch p.EnforcementLevel {
+		case HardMandatory:
+			hard = true
+		case SoftMandatory:
+			soft = true
+		case Advisory:
+			advisory = true
+		}
+	}
+
+	switch {
+	case hard:
+		result.Outcome = Failed
+	case soft:
+		result.Outcome = Failed
+		result.Overridable = true
+	case advisory:
+		result.Outcome = AdvisoryFailed
+	}
+
+	return result, nil
+}
+
+// CheckDir loads the policy group from the directory and checks it
+// against the plan JSON file.
+func CheckDir(ctx context.Context, dir, planFile string, ev Evaluator) (*Result, error) {
+	group, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := ioutil.ReadFile(planFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading plan: %v", err)
+	}
+
+	return Check(ctx, group, plan, ev)
+}
@@ -0,0 +1,149 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// deny returns an evaluator that fails the given policies.
+func deny(names ...string) Evaluator {
+	return EvaluatorFunc(func(ctx context.Context, p *Policy, input interface{}) ([]string, error) {
+		if _, ok := input.(map[string]interface{})["tfplan"]; !ok {
+			return nil, errors.New("tfplan is missing from input")
+		}
+		for _, name := range names {
+			if p.Name == name {
+				return []string{p.Name + " failed"}, nil
+			}
+		}
+		return nil, nil
+	})
+}
+
+func TestLoad(t *testing.T) {
+	group, err := Load(filepath.Join("testdata", "group"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if group.Version != "v1" {
+		t.Errorf("expected version v1, got %q", group.Version)
+	}
+
+	expected := []struct {
+		name    string
+		enabled bool
+		level   EnforcementLevel
+	}{
+		{"instance_types", true, HardMandatory},
+		{"required_tags", true, SoftMandatory},
+		{"region", true, Advisory},
+		{"legacy", false, HardMandatory},
+	}
+	if len(group.Policies) != len(expected) {
+		t.Fatalf("expected %d policies, got %d", len(expected), len(group.Policies))
+	}
+	for i, e := range expected {
+		p := group.Policies[i]
+		if p.Name != e.name || p.Enabled != e.enabled || p.EnforcementLevel != e.level {
+			t.Errorf("policy %d: expected %v, got %+v", i, e, p)
+		}
+		if !strings.Contains(p.Module, "package terraform") {
+			t.Errorf("policy %s: the Rego module was not loaded", p.Name)
+		}
+	}
+}
+
+func TestLoad_errors(t *testing.T) {
+	cases := map[string]string{
+		"missing config":   "no such file",
+		"invalid level":    "enforcement_level must be one of",
+		"missing module":   "error reading policy \"missing\"",
+		"duplicate policy": "declared more than once",
+		"invalid hcl":      "error parsing scalr-policy.hcl",
+		"missing level":    "enforcement_level must be one of",
+	}
+	configs := map[string]string{
+		"invalid level":    `policy "p" { enforcement_level = "mandatory" }`,
+		"missing module":   `policy "missing" { enforcement_level = "advisory" }`,
+		"duplicate policy": `policy "p" { enforcement_level = "advisory" } policy "p" { enforcement_level = "advisory" }`,
+		"invalid hcl":      `policy "p" {`,
+		"missing level":    `policy "p" {}`,
+	}
+
+	for name, expected := range cases {
+		dir := t.TempDir()
+		if cfg, ok := configs[name]; ok {
+			writeFile(t, filepath.Join(dir, ConfigFile), cfg)
+			writeFile(t, filepath.Join(dir, "p.rego"), "package terraform")
+		}
+
+		_, err := Load(dir)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%s: expected error containing %q, got %v", name, expected, err)
+		}
+	}
+}
+
+func TestCheckDir(t *testing.T) {
+	dir := filepath.Join("testdata", "group")
+	plan := filepath.Join("testdata", "plan.json")
+
+	cases := []struct {
+		failing     []string
+		outcome     Outcome
+		overridable bool
+	}{
+		{nil, Passed, false},
+		{[]string{"legacy"}, Passed, false},
+		{[]string{"region"}, AdvisoryFailed, false},
+		{[]string{"region", "required_tags"}, Failed, true},
+		{[]string{"required_tags", "instance_types"}, Failed, false},
+	}
+
+	for _, c := range cases {
+		result, err := CheckDir(context.Background(), dir, plan, deny(c.failing...))
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", c.failing, err)
+		}
+		if result.Outcome != c.outcome || result.Overridable != c.overridable {
+			t.Errorf("%v: expected %s (overridable=%t), got %s (overridable=%t)",
+				c.failing, c.outcome, c.overridable, result.Outcome, result.Overridable)
+		}
+		// Disabled policies are not evaluated.
+		if len(result.Policies) != 3 {
+			t.Errorf("%v: expected 3 policy results, got %d", c.failing, len(result.Policies))
+		}
+	}
+}
+
+func TestCheck_errors(t *testing.T) {
+	group, err := Load(filepath.Join("testdata", "group"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = Check(context.Background(), group, []byte("{"), deny())
+	if err == nil || !strings.Contains(err.Error(), "error decoding plan") {
+		t.Errorf("expected plan decoding error, got %v", err)
+	}
+
+	failing := EvaluatorFunc(func(ctx context.Context, p *Policy, input interface{}) ([]string, error) {
+		return nil, errors.New("rego_parse_error")
+	})
+	_, err = Check(context.Background(), group, []byte("{}"), failing)
+	if err == nil || !strings.Contains(err.Error(), `error evaluating policy "instance_types": rego_parse_error`) {
+		t.Errorf("expected evaluation error, got %v", err)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
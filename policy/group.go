@@ -0,0 +1,110 @@
+// Package policy helps to test the OPA policy groups of Scalr locally.
+//
+// A policy group is a directory with a scalr-policy.hcl file that declares
+// the policies and their enforcement levels, and a <name>.rego file for every
+// policy, which is the layout Scalr expects to find in the VCS repository of
+// a scalr_policy_group. Check evaluates a group against a Terraform plan
+// and aggregates the results the same way Scalr does.
+package policy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl"
+)
+
+// ConfigFile is the name of the file that declares the policies of a group.
+const ConfigFile = "scalr-policy.hcl"
+
+// EnforcementLevel defines what a failed policy means for the run.
+type EnforcementLevel string
+
+// List of available enforcement levels.
+const (
+	// HardMandatory failures stop the run.
+	HardMandatory EnforcementLevel = "hard-mandatory"
+	// SoftMandatory failures stop the run until they are overridden.
+	SoftMandatory EnforcementLevel = "soft-mandatory"
+	// Advisory failures are only reported.
+	Advisory EnforcementLevel = "advisory"
+)
+
+// Policy is a single OPA policy of a group.
+type Policy struct {
+	Name             string
+	Enabled          bool
+	EnforcementLevel EnforcementLevel
+	// Module is the Rego source of the policy.
+	Module string
+	// Path is the path of the Rego file.
+	Path string
+}
+
+// Group is a policy group loaded from a directory.
+type Group struct {
+	Dir      string
+	Version  string
+	Policies []*Policy
+}
+
+type groupConfig struct {
+	Version  string         `hcl:"version"`
+	Policies []policyConfig `hcl:"policy"`
+}
+
+type policyConfig struct {
+	Name             string `hcl:",key"`
+	Enabled          *bool  `hcl:"enabled"`
+	EnforcementLevel string `hcl:"enforcement_level"`
+}
+
+// Load reads the policy group from the directory.
+// Policies are enabled unless stated otherwise, the enforcement level is required.
+func Load(dir string) (*Group, error) {
+	raw, err := ioutil.ReadFile(filepath.Join(dir, ConfigFile))
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy group config: %v", err)
+	}
+
+	var cfg groupConfig
+	if err := hcl.Decode(&cfg, string(raw)); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %v", ConfigFile, err)
+	}
+
+	group := &Group{Dir: dir, Version: cfg.Version}
+	seen := make(map[string]bool)
+	for _, pc := range cfg.Policies {
+		if seen[pc.Name] {
+			return nil, fmt.Errorf("policy %q is declared more than once", pc.Name)
+		}
+		seen[pc.Name] = true
+
+		level := EnforcementLevel(pc.EnforcementLevel)
+		switch level {
+		case HardMandatory, SoftMandatory, Advisory:
+		default:
+			return nil, fmt.Errorf(
+				"policy %q: enforcement_level must be one of [%s, %s, %s], got: %q",
+				pc.Name, HardMandatory, SoftMandatory, Advisory, pc.EnforcementLevel,
+			)
+		}
+
+		p := &Policy{
+			Name:             pc.Name,
+			Enabled:          pc.Enabled == nil || *pc.Enabled,
+			EnforcementLevel: level,
+			Path:             filepath.Join(dir, pc.Name+".rego"),
+		}
+		module, err := ioutil.ReadFile(p.Path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading policy %q: %v", pc.Name, err)
+		}
+		p.Module = string(module)
+
+		group.Policies = append(group.Policies, p)
+	}
+
+	return group, nil
+}
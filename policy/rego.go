@@ -0,0 +1,53 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// Rego evaluates the policies with the embedded OPA library.
+// The deny rule of the policy package is queried, every value
+// of it is a message of a failed policy.
+type Rego struct{}
+
+// Evaluate implements Evaluator.
+func (Rego) Evaluate(ctx context.Context, p *Policy, input interface{}) ([]string, error) {
+	module, err := ast.ParseModule(p.Path, p.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	query := module.Package.Path.String() + ".deny"
+	rs, err := rego.New(
+		rego.Query(query),
+		rego.ParsedModule(module),
+		rego.Input(input),
+	).Eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, r := range rs {
+		for _, expr := range r.Expressions {
+			values, ok := expr.Value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s must be a set, got: %v", query, expr.Value)
+			}
+			for _, v := range values {
+				if s, ok := v.(string); ok {
+					messages = append(messages, s)
+				} else {
+					messages = append(messages, fmt.Sprint(v))
+				}
+			}
+		}
+	}
+	sort.Strings(messages)
+
+	return messages, nil
+}
@@ -0,0 +1,83 @@
+package policy
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckDir_rego(t *testing.T) {
+	dir := filepath.Join("testdata", "group")
+
+	cases := []struct {
+		plan        string
+		outcome     Outcome
+		overridable bool
+		messages    map[string][]string
+	}{
+		{"plan.json", Passed, false, nil},
+		{"plan_advisory.json", AdvisoryFailed, false, map[string][]string{
+			"region": {"aws_instance.web: availability zone eu-west-1a is outside of us-east-1"},
+		}},
+		{"plan_soft_mandatory.json", Failed, true, map[string][]string{
+			"region":        {"aws_instance.web: availability zone eu-west-1a is outside of us-east-1"},
+			"required_tags": {"aws_instance.web: tag owner is required"},
+		}},
+		{"plan_hard_mandatory.json", Failed, false, map[string][]string{
+			"instance_types": {"aws_instance.web: instance type m5.large is not allowed"},
+			"required_tags":  {"aws_instance.web: tag owner is required"},
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.plan, func(t *testing.T) {
+			result, err := CheckDir(context.Background(), dir, filepath.Join("testdata", c.plan), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Outcome != c.outcome || result.Overridable != c.overridable {
+				t.Errorf("expected %s (overridable=%t), got %s (overridable=%t)",
+					c.outcome, c.overridable, result.Outcome, result.Overridable)
+			}
+
+			// The disabled legacy policy would fail every plan, but it's not evaluated.
+			messages := make(map[string][]string)
+			for _, pr := range result.Policies {
+				if pr.Policy.Name == "legacy" {
+					t.Errorf("disabled policy legacy was evaluated")
+				}
+				if !pr.Passed() {
+					messages[pr.Policy.Name] = pr.Messages
+				}
+			}
+			if len(messages) == 0 {
+				messages = nil
+			}
+			if !reflect.DeepEqual(messages, c.messages) {
+				t.Errorf("wrong messages\ngot:  %v\nwant: %v", messages, c.messages)
+			}
+		})
+	}
+}
+
+func TestRego_errors(t *testing.T) {
+	cases := map[string]struct {
+		module   string
+		expected string
+	}{
+		"invalid module":    {"package terraform\n\ndeny[reason] {", "rego_parse_error"},
+		"deny is not a set": {"package terraform\n\ndeny = true", "data.terraform.deny must be a set"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &Policy{Name: "p", Path: "p.rego", Module: c.module}
+			_, err := Rego{}.Evaluate(context.Background(), p, map[string]interface{}{})
+			if err == nil || !strings.Contains(err.Error(), c.expected) {
+				t.Errorf("expected error containing %q, got %v", c.expected, err)
+			}
+		})
+	}
+}
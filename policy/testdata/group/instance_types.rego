@@ -0,0 +1,11 @@
+package terraform
+
+allowed_types := {"t3.micro", "t3.small"}
+
+deny[reason] {
+  resource := input.tfplan.resource_changes[_]
+  resource.type == "aws_instance"
+  instance_type := resource.change.after.instance_type
+  not allowed_types[instance_type]
+  reason := sprintf("%s: instance type %s is not allowed", [resource.address, instance_type])
+}
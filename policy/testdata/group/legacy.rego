@@ -0,0 +1,8 @@
+package terraform
+
+deny[reason] {
+  resource := input.tfplan.resource_changes[_]
+  resource.type == "aws_instance"
+  resource.change.actions[_] == "create"
+  reason := sprintf("%s: new instances are not allowed", [resource.address])
+}
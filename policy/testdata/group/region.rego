@@ -0,0 +1,9 @@
+package terraform
+
+deny[reason] {
+  resource := input.tfplan.resource_changes[_]
+  resource.type == "aws_instance"
+  zone := resource.change.after.availability_zone
+  not startswith(zone, "us-east-1")
+  reason := sprintf("%s: availability zone %s is outside of us-east-1", [resource.address, zone])
+}
@@ -0,0 +1,8 @@
+package terraform
+
+deny[reason] {
+  resource := input.tfplan.resource_changes[_]
+  resource.type == "aws_instance"
+  not resource.change.after.tags.owner
+  reason := sprintf("%s: tag owner is required", [resource.address])
+}
@@ -0,0 +1,19 @@
+version = "v1"
+
+policy "instance_types" {
+  enabled           = true
+  enforcement_level = "hard-mandatory"
+}
+
+policy "required_tags" {
+  enforcement_level = "soft-mandatory"
+}
+
+policy "region" {
+  enforcement_level = "advisory"
+}
+
+policy "legacy" {
+  enabled           = false
+  enforcement_level = "hard-mandatory"
+}
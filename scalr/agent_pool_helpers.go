@@ -0,0 +1,43 @@
+package scalr
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+)
+
+// agentsSchema returns the schema of the agents connected to an agent pool.
+func agentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"os": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// flattenAgents returns the agents in the format of agentsSchema.
+func flattenAgents(agents []*scalr.Agent) []interface{} {
+	result := make([]interface{}, 0, len(agents))
+	for _, agent := range agents {
+		result = append(result, map[string]interface{}{
+			"id":   agent.ID,
+			"name": agent.Name,
+			"os":   agent.OS,
+		})
+	}
+	return result
+}
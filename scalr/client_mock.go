@@ -18,6 +18,14 @@ type mockVariables struct {
 	ids map[string]*scalr.Variable
 }
 
+type mockEnvironments struct {
+	ids map[string]*scalr.Environment
+}
+
+type mockAccessPolicies struct {
+	ids map[string]*scalr.AccessPolicy
+}
+
 func newMockWorkspaces() *mockWorkspaces {
 	return &mockWorkspaces{
 		workspaceNames: make(map[workspaceNamesKey]*scalr.Workspace),
@@ -30,6 +38,18 @@ func newMockVariables() *mockVariables {
 	}
 }
 
+func newMockEnvironments() *mockEnvironments {
+	return &mockEnvironments{
+		ids: make(map[string]*scalr.Environment),
+	}
+}
+
+func newMockAccessPolicies() *mockAccessPolicies {
+	return &mockAccessPolicies{
+		ids: make(map[string]*scalr.AccessPolicy),
+	}
+}
+
 func (m *mockWorkspaces) List(ctx context.Context, options scalr.WorkspaceListOptions) (*scalr.WorkspaceList, error) {
 	panic("not implemented")
 }
@@ -78,7 +98,13 @@ func (m *mockWorkspaces) Read(ctx context.Context, environment string, workspace
 }
 
 func (m *mockWorkspaces) ReadByID(ctx context.Context, workspaceID string) (*scalr.Workspace, error) {
-	panic("not implemented")
+	for _, w := range m.workspaceNames {
+		if w.ID == workspaceID {
+			return w, nil
+		}
+	}
+
+	return nil, scalr.ErrResourceNotFound{}
 }
 
 func (m *mockWorkspaces) Update(ctx context.Context, workspaceID string, options scalr.WorkspaceUpdateOptions) (*scalr.Workspace, error) {
@@ -96,3 +122,101 @@ func (m *mockVariables) Update(ctx context.Context, variableID string, options s
 func (m *mockVariables) Delete(ctx context.Context, variableID string) error {
 	panic("not implemented")
 }
+
+func (m *mockAccessPolicies) List(ctx context.Context, options scalr.AccessPolicyListOptions) (*scalr.AccessPolicyList, error) {
+	apl := &scalr.AccessPolicyList{
+		Pagination: &scalr.Pagination{CurrentPage: 1, TotalPages: 1},
+	}
+
+	for _, ap := range m.ids {
+		if options.Workspace != nil && (ap.Workspace == nil || ap.Workspace.ID != *options.Workspace) {
+			continue
+		}
+		if options.Environment != nil && (ap.Environment == nil || ap.Environment.ID != *options.Environment) {
+			continue
+		}
+		if options.Account != nil && (ap.Account == nil || ap.Account.ID != *options.Account) {
+			continue
+		}
+		if options.User != nil && (ap.User == nil || ap.User.ID != *options.User) {
+			continue
+		}
+		if options.Team != nil && (ap.Team == nil || ap.Team.ID != *options.Team) {
+			continue
+		}
+		if options.ServiceAccount != nil && (ap.ServiceAccount == nil || ap.ServiceAccount.ID != *options.ServiceAccount) {
+			continue
+		}
+		apl.Items = append(apl.Items, ap)
+	}
+	apl.TotalCount = len(apl.Items)
+
+	return apl, nil
+}
+
+func (m *mockAccessPolicies) Create(ctx context.Context, options scalr.AccessPolicyCreateOptions) (*scalr.AccessPolicy, error) {
+	ap := &scalr.AccessPolicy{
+		ID:             options.ID,
+		Roles:          options.Roles,
+		User:           options.User,
+		Team:           options.Team,
+		ServiceAccount: options.ServiceAccount,
+		Account:        options.Account,
+		Environment:    options.Environment,
+		Workspace:      options.Workspace,
+	}
+
+	m.ids[options.ID] = ap
+
+	return ap, nil
+}
+
+func (m *mockAccessPolicies) Read(ctx context.Context, accessPolicyID string) (*scalr.AccessPolicy, error) {
+	ap := m.ids[accessPolicyID]
+	if ap == nil {
+		return nil, scalr.ErrResourceNotFound{}
+	}
+
+	return ap, nil
+}
+
+func (m *mockAccessPolicies) Update(ctx context.Context, accessPolicyID string, options scalr.AccessPolicyUpdateOptions) (*scalr.AccessPolicy, error) {
+	panic("not implemented")
+}
+
+func (m *mockAccessPolicies) Delete(ctx context.Context, accessPolicyID string) error {
+	panic("not implemented")
+}
+
+func (m *mockEnvironments) List(ctx context.Context, options scalr.EnvironmentListOptions) (*scalr.EnvironmentList, error) {
+	panic("not implemented")
+}
+
+func (m *mockEnvironments) Read(ctx context.Context, environmentID string) (*scalr.Environment, error) {
+	env := m.ids[environmentID]
+	if env == nil {
+		return nil, scalr.ErrResourceNotFound{}
+	}
+
+	return env, nil
+}
+
+func (m *mockEnvironments) Create(ctx context.Context, options scalr.EnvironmentCreateOptions) (*scalr.Environment, error) {
+	env := &scalr.Environment{
+		ID:      options.ID,
+		Name:    *options.Name,
+		Account: options.Account,
+	}
+
+	m.ids[options.ID] = env
+
+	return env, nil
+}
+
+func (m *mockEnvironments) Update(ctx context.Context, environmentID string, options scalr.EnvironmentUpdateOptions) (*scalr.Environment, error) {
+	panic("not implemented")
+}
+
+func (m *mockEnvironments) Delete(ctx context.Context, environmentID string) error {
+	panic("not implemented")
+}
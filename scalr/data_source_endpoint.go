@@ -51,6 +51,11 @@ func dataSourceScalrEndpoint() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -79,6 +84,9 @@ func dataSourceScalrEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if endpoint.Environment != nil {
 		d.Set("environment_id", endpoint.Environment.ID)
 	}
+	if endpoint.Account != nil {
+		d.Set("account_id", endpoint.Account.ID)
+	}
 	d.SetId(endpointID)
 
 	return nil
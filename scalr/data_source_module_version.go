@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
+	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	scalr "github.com/scalr/go-scalr"
 )
@@ -20,6 +22,12 @@ func dataSourceModuleVersion() *schema.Resource {
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
+			},
+			"include_prerelease": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"id": {
 				Type:     schema.TypeString,
@@ -42,10 +50,14 @@ func dataSourceModuleVersionRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Download module by source: %s", source)
 
 	var mv *scalr.ModuleVersion
-	var version string
+	var constraint string
 	if v, ok := d.GetOk("version"); ok {
-		version = v.(string)
-		mv, err = scalrClient.ModuleVersions.ReadBySemanticVersion(ctx, module.ID, version)
+		constraint = v.(string)
+		if _, verr := version.NewVersion(constraint); verr == nil {
+			mv, err = scalrClient.ModuleVersions.ReadBySemanticVersion(ctx, module.ID, constraint)
+		} else {
+			mv, err = resolveModuleVersionConstraint(scalrClient, module.ID, constraint, d.Get("include_prerelease").(bool))
+		}
 	} else {
 		if module.LatestModuleVersion == nil {
 			return errors.New("The module has no version tags")
@@ -55,13 +67,116 @@ func dataSourceModuleVersionRead(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		if errors.Is(err, scalr.ErrResourceNotFound{}) {
-			return fmt.Errorf("Could not find module with source %s  and version %s", source, version)
+			return fmt.Errorf("Could not find module with source %s  and version %s", source, constraint)
 		}
 		return fmt.Errorf("Error retrieving module version: %v", err)
 	}
-	log.Printf("[DEBUG] Download module version by source %s version: %s", source, version)
+	log.Printf("[DEBUG] Download module version by source %s version: %s", source, mv.Version)
 
 	d.SetId(mv.ID)
 	d.Set("version", mv.Version)
 	return nil
 }
+
+// resolveModuleVersionConstraint lists the versions of the module
+// and returns the highest one that matches the constraint.
+func resolveModuleVersionConstraint(
+	scalrClient *scalr.Client, moduleID, constraint string, includePrerelease bool,
+) (*scalr.ModuleVersion, error) {
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid version constraint %q: %v", constraint, err)
+	}
+
+	versions, err := getModuleVersions(scalrClient, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	mv := matchModuleVersion(versions, constraints, includePrerelease)
+	if mv == nil {
+		return nil, fmt.Errorf("No version of module %s matches the constraint %q", moduleID, constraint)
+	}
+
+	return mv, nil
+}
+
+// matchModuleVersion returns the highest published version that satisfies the constraints,
+// or nil if there is none. Pre-release versions are skipped unless includePrerelease is set.
+func matchModuleVersion(
+	versions []*scalr.ModuleVersion, constraints version.Constraints, includePrerelease bool,
+) *scalr.ModuleVersion {
+	var match *scalr.ModuleVersion
+	var matchVersion *version.Version
+
+	for _, mv := range versions {
+		if mv.Status != scalr.ModuleVersionOk {
+			continue
+		}
+
+		v, err := version.NewVersion(mv.Version)
+		if err != nil {
+			log.Printf("[DEBUG] Skip module version %s: %v", mv.ID, err)
+			continue
+		}
+		if v.Prerelease() != "" {
+			if !includePrerelease || !checkPrerelease(constraints, v) {
+				continue
+			}
+		} else if !constraints.Check(v) {
+			continue
+		}
+		if matchVersion == nil || v.GreaterThan(matchVersion) {
+			match, matchVersion = mv, v
+		}
+	}
+
+	return match
+}
+
+// checkPrerelease checks a pre-release version against the constraints.
+// Constraints only match pre-releases of the same release on their own,
+// so the upper bounds are checked with the release part of the version,
+// and the lower bounds with the whole version: 1.5.0-beta.1 matches
+// "~> 1.4" but neither ">= 1.5.0" nor "< 1.5.0".
+func checkPrerelease(constraints version.Constraints, v *version.Version) bool {
+	for _, c := range constraints {
+		if c.Check(v) {
+			continue
+		}
+		if !c.Check(v.Core()) {
+			return false
+		}
+
+		op, bound := parseConstraint(c)
+		if bound == nil {
+			return false
+		}
+		switch op {
+		case ">":
+			if !v.GreaterThan(bound) {
+				return false
+			}
+		case ">=", "~>":
+			if v.LessThan(bound) {
+				return false
+			}
+		case "", "=":
+			if !v.Equal(bound) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// parseConstraint returns the operator and the version of a single constraint.
+func parseConstraint(c *version.Constraint) (string, *version.Version) {
+	s := strings.TrimSpace(c.String())
+	v := strings.TrimLeft(s, "<>=!~ ")
+	bound, err := version.NewVersion(v)
+	if err != nil {
+		return "", nil
+	}
+	return strings.TrimSpace(s[:len(s)-len(v)]), bound
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	scalr "github.com/scalr/go-scalr"
 )
@@ -49,12 +50,70 @@ func TestAccModuleVersionDataSource_basic(t *testing.T) {
 						"data.scalr_module_version.latest", "version",
 						"data.scalr_module_version.version", "version",
 					),
+
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_module_version.constraint", "version",
+						"data.scalr_module_version.latest", "version",
+					),
 				),
 			},
 		},
 	})
 }
 
+func TestMatchModuleVersion(t *testing.T) {
+	versions := []*scalr.ModuleVersion{
+		{ID: "modver-1", Version: "1.3.0", Status: scalr.ModuleVersionOk},
+		{ID: "modver-2", Version: "1.4.0", Status: scalr.ModuleVersionOk},
+		{ID: "modver-3", Version: "1.4.2", Status: scalr.ModuleVersionOk},
+		{ID: "modver-4", Version: "1.4.3", Status: scalr.ModuleVersionErrored},
+		{ID: "modver-5", Version: "1.5.0-beta.1", Status: scalr.ModuleVersionOk},
+		{ID: "modver-6", Version: "2.0.0", Status: scalr.ModuleVersionOk},
+		{ID: "modver-7", Version: "2.1.0", Status: scalr.ModuleVersionPending},
+	}
+
+	prereleases := []*scalr.ModuleVersion{
+		{ID: "modver-1", Version: "1.4.0", Status: scalr.ModuleVersionOk},
+		{ID: "modver-5", Version: "1.5.0-beta.1", Status: scalr.ModuleVersionOk},
+	}
+
+	cases := []struct {
+		versions          []*scalr.ModuleVersion
+		constraint        string
+		includePrerelease bool
+		expected          string
+	}{
+		{versions, "~> 1.4", false, "modver-3"},
+		{versions, "~> 1.4.0", false, "modver-3"},
+		{versions, ">= 1.0, < 2.0", false, "modver-3"},
+		{versions, ">= 1.0, < 2.0", true, "modver-5"},
+		{versions, ">= 2.0", false, "modver-6"},
+		{versions, "< 1.3", false, ""},
+		{prereleases, "~> 1.4", true, "modver-5"},
+		{prereleases, ">= 1.5.0", true, ""},
+		{prereleases, ">= 1.5.0-alpha", true, "modver-5"},
+		{prereleases, "< 1.5.0", true, "modver-1"},
+		{prereleases, "1.5.0-beta.1", true, "modver-5"},
+		{prereleases, "1.5.0-beta.1", false, ""},
+	}
+
+	for _, c := range cases {
+		constraints, err := version.NewConstraint(c.constraint)
+		if err != nil {
+			t.Fatalf("invalid constraint %q: %v", c.constraint, err)
+		}
+
+		mv := matchModuleVersion(c.versions, constraints, c.includePrerelease)
+		var got string
+		if mv != nil {
+			got = mv.ID
+		}
+		if got != c.expected {
+			t.Errorf("%q (include_prerelease=%t): expected %q, got %q", c.constraint, c.includePrerelease, c.expected, got)
+		}
+	}
+}
+
 func waitForModuleVersions(environmentName string) func() {
 	return func() {
 		scalrClient := testAccProvider.Meta().(*scalr.Client)
@@ -132,5 +191,10 @@ func testAccModuleVersionDataSourceConfig(rInt int) string {
   			source = scalr_module.test.source
 			version = data.scalr_module_version.latest.version
 		}
+
+		data "scalr_module_version" "constraint" {
+  			source = scalr_module.test.source
+			version = ">= 0.0.1"
+		}
 	`, testAccScalrAccountModule(rInt))
 }
@@ -0,0 +1,371 @@
+package scalr
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func dataSourceScalrAccessPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceScalrAccessPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								if err := Scope(v).IsValid(); err != nil {
+									errs = append(errs, fmt.Errorf("%s must be one of [workspace, environment, account], got: %s", key, v))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"subject": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								if err := Subject(v).IsValid(); err != nil {
+									errs = append(errs, fmt.Errorf("%s must be one of [user, team, service_account], got: %s", key, v))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"include_inherited": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_system": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"inherited": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"subject": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"role_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"permissions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// accessPolicyQuery is a single List call made by the data source,
+// along with the scope the matched policies must be attached to.
+type accessPolicyQuery struct {
+	scopeType Scope
+	scopeID   string
+	options   scalr.AccessPolicyListOptions
+}
+
+func dataSourceScalrAccessPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	var scopeType Scope
+	var scopeID string
+	if v, ok := d.GetOk("scope"); ok {
+		scope := v.([]interface{})[0].(map[string]interface{})
+		scopeType = Scope(scope["type"].(string))
+		scopeID = scope["id"].(string)
+	}
+
+	var subjectType Subject
+	var subjectID string
+	if v, ok := d.GetOk("subject"); ok {
+		subject := v.([]interface{})[0].(map[string]interface{})
+		subjectType = Subject(subject["type"].(string))
+		subjectID = subject["id"].(string)
+	}
+
+	if scopeID == "" && subjectID == "" {
+		return errors.New("At least one of 'scope' or 'subject' must be set")
+	}
+
+	// The scopes to query, starting with the requested one.
+	var queries []accessPolicyQuery
+	if scopeID != "" {
+		scopes := map[Scope]string{scopeType: scopeID}
+		if d.Get("include_inherited").(bool) {
+			var err error
+			scopes, err = getAccessPolicyScopeChain(scopeType, scopeID, scalrClient)
+			if err != nil {
+				return err
+			}
+		}
+		for _, t := range []Scope{Workspace, Environment, Account} {
+			id, ok := scopes[t]
+			if !ok {
+				continue
+			}
+			query := accessPolicyQuery{scopeType: t, scopeID: id}
+			switch t {
+			case Workspace:
+				query.options.Workspace = scalr.String(id)
+			case Environment:
+				query.options.Environment = scalr.String(id)
+			case Account:
+				query.options.Account = scalr.String(id)
+			}
+			queries = append(queries, query)
+		}
+	} else {
+		queries = append(queries, accessPolicyQuery{})
+	}
+
+	for i := range queries {
+		switch subjectType {
+		case User:
+			queries[i].options.User = scalr.String(subjectID)
+		case Team:
+			queries[i].options.Team = scalr.String(subjectID)
+		case ServiceAccount:
+			queries[i].options.ServiceAccount = scalr.String(subjectID)
+		}
+	}
+
+	roles := make(map[string]*scalr.Role)
+	seen := make(map[string]bool)
+	policies := make([]map[string]interface{}, 0)
+
+	for _, query := range queries {
+		for {
+			log.Printf("[DEBUG] Read access policies for %s %s %s %s", scopeType, scopeID, subjectType, subjectID)
+			apl, err := scalrClient.AccessPolicies.List(ctx, query.options)
+			if err != nil {
+				return fmt.Errorf("Error retrieving access policies: %v", err)
+			}
+
+			for _, ap := range apl.Items {
+				if seen[ap.ID] {
+					continue
+				}
+				if query.scopeID != "" && accessPolicyScopeID(ap, query.scopeType) != query.scopeID {
+					continue
+				}
+				if subjectID != "" && accessPolicySubjectID(ap, subjectType) != subjectID {
+					continue
+				}
+				seen[ap.ID] = true
+
+				policy, err := flattenAccessPolicy(ap, roles, scalrClient)
+				if err != nil {
+					return err
+				}
+				policy["inherited"] = scopeID != "" && query.scopeType != scopeType
+				policies = append(policies, policy)
+			}
+
+			// Exit the loop when we've seen all pages.
+			if apl.CurrentPage >= apl.TotalPages {
+				break
+			}
+
+			// Update the page number to get the next page.
+			query.options.PageNumber = apl.NextPage
+		}
+	}
+
+	d.Set("policies", policies)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(
+		[]string{string(scopeType), scopeID, string(subjectType), subjectID}, "/",
+	))))
+
+	return nil
+}
+
+// getAccessPolicyScopeChain returns the given scope together with
+// the scopes it inherits access policies from.
+func getAccessPolicyScopeChain(scopeType Scope, scopeID string, scalrClient *scalr.Client) (map[Scope]string, error) {
+	scopes := map[Scope]string{scopeType: scopeID}
+
+	if scopeType == Workspace {
+		ws, err := scalrClient.Workspaces.ReadByID(ctx, scopeID)
+		if err != nil {
+			if errors.Is(err, scalr.ErrResourceNotFound{}) {
+				return nil, fmt.Errorf("Workspace %s not found", scopeID)
+			}
+			return nil, fmt.Errorf("Error retrieving workspace %s: %v", scopeID, err)
+		}
+		scopes[Environment] = ws.Environment.ID
+	}
+
+	if envID, ok := scopes[Environment]; ok {
+		env, err := scalrClient.Environments.Read(ctx, envID)
+		if err != nil {
+			if errors.Is(err, scalr.ErrResourceNotFound{}) {
+				return nil, fmt.Errorf("Environment %s not found", envID)
+			}
+			return nil, fmt.Errorf("Error retrieving environment %s: %v", envID, err)
+		}
+		scopes[Account] = env.Account.ID
+	}
+
+	return scopes, nil
+}
+
+// flattenAccessPolicy converts the access policy into the data source attributes,
+// expanding its roles. The roles are cached, as most policies share the same ones.
+func flattenAccessPolicy(ap *scalr.AccessPolicy, roles map[string]*scalr.Role, scalrClient *scalr.Client) (map[string]interface{}, error) {
+	subject := make(map[string]interface{})
+	if ap.User != nil {
+		subject["type"] = User
+		subject["id"] = ap.User.ID
+	} else if ap.Team != nil {
+		subject["type"] = Team
+		subject["id"] = ap.Team.ID
+	} else if ap.ServiceAccount != nil {
+		subject["type"] = ServiceAccount
+		subject["id"] = ap.ServiceAccount.ID
+	} else {
+		return nil, fmt.Errorf("Unable to extract subject from access policy %s", ap.ID)
+	}
+
+	scope := make(map[string]interface{})
+	if ap.Workspace != nil {
+		scope["type"] = Workspace
+		scope["id"] = ap.Workspace.ID
+	} else if ap.Environment != nil {
+		scope["type"] = Environment
+		scope["id"] = ap.Environment.ID
+	} else if ap.Account != nil {
+		scope["type"] = Account
+		scope["id"] = ap.Account.ID
+	} else {
+		return nil, fmt.Errorf("Unable to extract scope from access policy %s", ap.ID)
+	}
+
+	roleIds := make([]interface{}, 0)
+	roleList := make([]interface{}, 0)
+	for _, r := range ap.Roles {
+		role, ok := roles[r.ID]
+		if !ok {
+			var err error
+			role, err = scalrClient.Roles.Read(ctx, r.ID)
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving role %s: %v", r.ID, err)
+			}
+			roles[r.ID] = role
+		}
+
+		permissions := make([]interface{}, 0)
+		for _, permission := range role.Permissions {
+			permissions = append(permissions, permission.ID)
+		}
+
+		roleIds = append(roleIds, role.ID)
+		roleList = append(roleList, map[string]interface{}{
+			"id":          role.ID,
+			"name":        role.Name,
+			"permissions": permissions,
+		})
+	}
+
+	return map[string]interface{}{
+		"id":        ap.ID,
+		"is_system": ap.IsSystem,
+		"scope":     []interface{}{scope},
+		"subject":   []interface{}{subject},
+		"role_ids":  roleIds,
+		"roles":     roleList,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package scalr
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccScalrAccessPoliciesDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAccessPoliciesDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_access_policies.by_scope", "id"),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_access_policies.by_scope", "policies.0.id",
+						"scalr_access_policy.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.0.inherited", "false"),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.0.subject.0.type", "user"),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.0.subject.0.id", testUser),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.0.scope.0.type", "environment"),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.0.role_ids.0", readOnlyRole),
+					resource.TestCheckResourceAttr("data.scalr_access_policies.by_scope", "policies.0.roles.0.id", readOnlyRole),
+					resource.TestCheckResourceAttrSet("data.scalr_access_policies.by_scope", "policies.0.roles.0.name"),
+					resource.TestCheckResourceAttrSet("data.scalr_access_policies.by_scope", "policies.0.roles.0.permissions.#"),
+					resource.TestCheckResourceAttrSet("data.scalr_access_policies.by_subject", "policies.#"),
+				),
+			},
+			{
+				Config:      testAccScalrAccessPoliciesDataSourceEmptyConfig(),
+				ExpectError: regexp.MustCompile("At least one of 'scope' or 'subject' must be set"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccScalrAccessPoliciesDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-access-policies-provider-data-source-%d"
+  account_id = "%s"
+}
+
+resource "scalr_access_policy" "test" {
+  subject {
+    type = "user"
+    id   = "%s"
+  }
+  scope {
+    type = "environment"
+    id   = scalr_environment.test.id
+  }
+  role_ids = [
+    "%s"
+  ]
+}
+
+data "scalr_access_policies" "by_scope" {
+  scope {
+    type = "environment"
+    id   = scalr_access_policy.test.scope[0].id
+  }
+  include_inherited = false
+}
+
+data "scalr_access_policies" "by_subject" {
+  subject {
+    type = "user"
+    id   = scalr_access_policy.test.subject[0].id
+  }
+}`, rInt, defaultAccount, testUser, readOnlyRole)
+}
+
+func testAccScalrAccessPoliciesDataSourceEmptyConfig() string {
+	return `
+data "scalr_access_policies" "test" {}`
+}
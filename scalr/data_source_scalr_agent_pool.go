@@ -40,6 +40,8 @@ func dataSourceScalrAgentPool() *schema.Resource {
 				MaxItems: 128,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"agents": agentsSchema(),
 		},
 	}
 }
@@ -83,6 +85,9 @@ func dataSourceScalrAgentPoolRead(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] agent pool %s workspaces: %+v", agentPool.ID, workspaces)
 		d.Set("workspace_ids", workspaces)
 	}
+
+	d.Set("agents", flattenAgents(agentPool.Agents))
+
 	d.SetId(agentPool.ID)
 
 	return nil
@@ -0,0 +1,56 @@
+package scalr
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func dataSourceScalrAgents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceScalrAgentsRead,
+		Schema: map[string]*schema.Schema{
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"agents": agentsSchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceScalrAgentsRead(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	poolID := d.Get("agent_pool_id").(string)
+
+	log.Printf("[DEBUG] Read agents of agent pool: %s", poolID)
+	agentPool, err := scalrClient.AgentPools.Read(ctx, poolID)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return fmt.Errorf("Could not find agent pool %s", poolID)
+		}
+		return fmt.Errorf("Error retrieving agent pool %s: %v", poolID, err)
+	}
+
+	ids := make([]string, 0)
+	for _, agent := range agentPool.Agents {
+		ids = append(ids, agent.ID)
+	}
+
+	d.Set("ids", ids)
+	d.Set("agents", flattenAgents(agentPool.Agents))
+	d.SetId(poolID)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package scalr
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccScalrAgentsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAgentsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_agents.test", "id",
+						"scalr_agent_pool.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_agents.test", "agents.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_agents.test", "ids.#", "0"),
+				),
+			},
+			{
+				Config:      testAccScalrAgentsDataSourceNotFoundConfig(),
+				ExpectError: regexp.MustCompile("Could not find agent pool apool-123"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccScalrAgentsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_agent_pool" "test" {
+  name       = "agent_pool-test-%d"
+  account_id = "%s"
+}
+
+data "scalr_agents" "test" {
+  agent_pool_id = scalr_agent_pool.test.id
+}`, rInt, defaultAccount)
+}
+
+func testAccScalrAgentsDataSourceNotFoundConfig() string {
+	return `
+data "scalr_agents" "test" {
+  agent_pool_id = "apool-123"
+}`
+}
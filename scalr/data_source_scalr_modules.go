@@ -0,0 +1,161 @@
+package scalr
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func dataSourceScalrModules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceScalrModulesRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"module_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"modules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"module_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceScalrModulesRead(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	options := scalr.ModuleListOptions{}
+	if accountID, ok := d.GetOk("account_id"); ok {
+		options.Account = scalr.String(accountID.(string))
+	}
+	if environmentID, ok := d.GetOk("environment_id"); ok {
+		options.Environment = scalr.String(environmentID.(string))
+	}
+	if name, ok := d.GetOk("name"); ok {
+		options.Name = scalr.String(name.(string))
+	}
+	if provider, ok := d.GetOk("module_provider"); ok {
+		options.Provider = scalr.String(provider.(string))
+	}
+
+	filters := []string{
+		d.Get("account_id").(string),
+		d.Get("environment_id").(string),
+		d.Get("name").(string),
+		d.Get("module_provider").(string),
+	}
+
+	ids := make([]string, 0)
+	modules := make([]interface{}, 0)
+	for {
+		log.Printf("[DEBUG] Read modules with filters: %v", filters)
+		ml, err := scalrClient.Modules.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving modules: %v", err)
+		}
+
+		for _, m := range ml.Items {
+			module := map[string]interface{}{
+				"id":              m.ID,
+				"name":            m.Name,
+				"module_provider": m.Provider,
+				"source":          m.Source,
+				"status":          string(m.Status),
+			}
+			if m.Account != nil {
+				module["account_id"] = m.Account.ID
+			}
+			if m.Environment != nil {
+				module["environment_id"] = m.Environment.ID
+			}
+			if m.LatestModuleVersion != nil {
+				mv, err := scalrClient.ModuleVersions.Read(ctx, m.LatestModuleVersion.ID)
+				if err != nil {
+					return fmt.Errorf("Error reading latest version of module %s: %v", m.ID, err)
+				}
+				module["latest_version_id"] = mv.ID
+				module["latest_version"] = mv.Version
+			}
+
+			ids = append(ids, m.ID)
+			modules = append(modules, module)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if ml.CurrentPage >= ml.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = ml.NextPage
+	}
+
+	d.Set("ids", ids)
+	d.Set("modules", modules)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(filters, "/"))))
+
+	return nil
+}
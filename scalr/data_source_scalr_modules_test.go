@@ -0,0 +1,80 @@
+package scalr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func TestAccScalrModulesDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testVcsAccGithubTokenPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrModulesDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scalr_modules.test", "modules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_modules.test", "modules.0.id",
+						"scalr_module.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_modules.test", "modules.0.source",
+						"scalr_module.test", "source",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_modules.test", "modules.0.environment_id",
+						"scalr_environment.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_modules.test", "ids.0",
+						"scalr_module.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_modules.empty", "modules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrModulesDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_vcs_provider test {
+  name     = "test-github-%[1]d"
+  vcs_type = "%[2]s"
+  token    = "%[3]s"
+}
+
+resource scalr_environment test {
+  name       = "test-env-%[1]d"
+  account_id = "%[4]s"
+}
+
+resource "scalr_module" "test" {
+  account_id     = "%[4]s"
+  environment_id = scalr_environment.test.id
+  vcs_repo {
+    identifier = "Scalr/terraform-scalr-revizor"
+  }
+  vcs_provider_id = scalr_vcs_provider.test.id
+}
+
+data "scalr_modules" "test" {
+  account_id     = "%[4]s"
+  environment_id = scalr_module.test.environment_id
+}
+
+data "scalr_modules" "empty" {
+  account_id      = "%[4]s"
+  environment_id  = scalr_module.test.environment_id
+  module_provider = "nonexistent-%[1]d"
+}`, rInt, string(scalr.Github), GITHUB_TOKEN, defaultAccount)
+}
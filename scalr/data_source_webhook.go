@@ -57,6 +57,11 @@ func dataSourceScalrWebhook() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -95,6 +100,9 @@ func dataSourceScalrWebhookRead(d *schema.ResourceData, meta interface{}) error
 	if webhook.Environment != nil {
 		d.Set("environment_id", webhook.Environment.ID)
 	}
+	if webhook.Account != nil {
+		d.Set("account_id", webhook.Account.ID)
+	}
 	if webhook.Endpoint != nil {
 		d.Set("endpoint_id", webhook.Endpoint.ID)
 	}
@@ -62,6 +62,11 @@ func dataSourceScalrWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"hooks": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -157,6 +162,7 @@ func dataSourceScalrWorkspaceRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("terraform_version", workspace.TerraformVersion)
 	d.Set("working_directory", workspace.WorkingDirectory)
 	d.Set("has_resources", workspace.HasResources)
+	d.Set("locked", workspace.Locked)
 
 	if workspace.ModuleVersion != nil {
 		d.Set("module_version_id", workspace.ModuleVersion.ID)
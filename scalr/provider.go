@@ -63,38 +63,44 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"scalr_access_policy":  dataSourceScalrAccessPolicy(),
-			"scalr_agent_pool":     dataSourceScalrAgentPool(),
-			"scalr_current_run":    dataSourceScalrCurrentRun(),
-			"scalr_endpoint":       dataSourceScalrEndpoint(),
-			"scalr_environment":    dataSourceScalrEnvironment(),
-			"scalr_iam_team":       dataSourceScalrIamTeam(),
-			"scalr_iam_user":       dataSourceScalrIamUser(),
-			"scalr_module_version": dataSourceModuleVersion(),
-			"scalr_policy_group":   dataSourceScalrPolicyGroup(),
-			"scalr_role":           dataSourceScalrRole(),
-			"scalr_vcs_provider":   dataSourceScalrVcsProvider(),
-			"scalr_webhook":        dataSourceScalrWebhook(),
-			"scalr_workspace":      dataSourceScalrWorkspace(),
-			"scalr_workspace_ids":  dataSourceScalrWorkspaceIDs(),
+			"scalr_access_policy":   dataSourceScalrAccessPolicy(),
+			"scalr_access_policies": dataSourceScalrAccessPolicies(),
+			"scalr_agent_pool":      dataSourceScalrAgentPool(),
+			"scalr_agents":          dataSourceScalrAgents(),
+			"scalr_modules":         dataSourceScalrModules(),
+			"scalr_current_run":     dataSourceScalrCurrentRun(),
+			"scalr_endpoint":        dataSourceScalrEndpoint(),
+			"scalr_environment":     dataSourceScalrEnvironment(),
+			"scalr_iam_team":        dataSourceScalrIamTeam(),
+			"scalr_iam_user":        dataSourceScalrIamUser(),
+			"scalr_module_version":  dataSourceModuleVersion(),
+			"scalr_policy_group":    dataSourceScalrPolicyGroup(),
+			"scalr_role":            dataSourceScalrRole(),
+			"scalr_vcs_provider":    dataSourceScalrVcsProvider(),
+			"scalr_webhook":         dataSourceScalrWebhook(),
+			"scalr_workspace":       dataSourceScalrWorkspace(),
+			"scalr_workspace_ids":   dataSourceScalrWorkspaceIDs(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"scalr_access_policy":        resourceScalrAccessPolicy(),
-			"scalr_agent_pool":           resourceScalrAgentPool(),
-			"scalr_agent_pool_token":     resourceScalrAgentPoolToken(),
-			"scalr_endpoint":             resourceScalrEndpoint(),
-			"scalr_environment":          resourceScalrEnvironment(),
-			"scalr_iam_team":             resourceScalrIamTeam(),
-			"scalr_module":               resourceScalrModule(),
-			"scalr_policy_group":         resourceScalrPolicyGroup(),
-			"scalr_policy_group_linkage": resourceScalrPolicyGroupLinkage(),
-			"scalr_role":                 resourceScalrRole(),
-			"scalr_variable":             resourceScalrVariable(),
-			"scalr_vcs_provider":         resourceScalrVcsProvider(),
-			"scalr_webhook":              resourceScalrWebhook(),
-			"scalr_workspace":            resourceScalrWorkspace(),
-			"scalr_run_trigger":          resourceScalrRunTrigger(),
+			"scalr_access_policy":                   resourceScalrAccessPolicy(),
+			"scalr_agent_pool":                      resourceScalrAgentPool(),
+			"scalr_agent_pool_token":                resourceScalrAgentPoolToken(),
+			"scalr_agent_pool_workspace_assignment": resourceScalrAgentPoolWorkspaceAssignment(),
+			"scalr_endpoint":                        resourceScalrEndpoint(),
+			"scalr_environment":                     resourceScalrEnvironment(),
+			"scalr_iam_team":                        resourceScalrIamTeam(),
+			"scalr_module":                          resourceScalrModule(),
+			"scalr_policy_group":                    resourceScalrPolicyGroup(),
+			"scalr_policy_group_linkage":            resourceScalrPolicyGroupLinkage(),
+			"scalr_role":                            resourceScalrRole(),
+			"scalr_variable":                        resourceScalrVariable(),
+			"scalr_vcs_provider":                    resourceScalrVcsProvider(),
+			"scalr_webhook":                         resourceScalrWebhook(),
+			"scalr_workspace":                       resourceScalrWorkspace(),
+			"scalr_workspace_from_template":         resourceScalrWorkspaceFromTemplate(),
+			"scalr_workspace_tfvars":                resourceScalrWorkspaceTfvars(),
+			"scalr_run_trigger":                     resourceScalrRunTrigger(),
 		},
 
 		ConfigureFunc: providerConfigure,
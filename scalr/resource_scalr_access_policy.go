@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	scalr "github.com/scalr/go-scalr"
@@ -47,7 +48,7 @@ func resourceScalrAccessPolicy() *schema.Resource {
 		Update: resourceScalrAccessPolicyUpdate,
 		Delete: resourceScalrAccessPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceScalrAccessPolicyImport,
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -133,6 +134,143 @@ func parseRoleIdDefinitions(d *schema.ResourceData) ([]*scalr.Role, error) {
 	return roles, nil
 }
 
+// resourceScalrAccessPolicyImport accepts either the access policy ID
+// or an ID of the form <scope_type>/<scope_id>/<subject_type>/<subject_id>.
+func resourceScalrAccessPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	scalrClient := meta.(*scalr.Client)
+
+	id := d.Id()
+	if !strings.Contains(id, "/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	scopeType, scopeID, subjectType, subjectID, err := unpackAccessPolicyImportID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	ap, err := findAccessPolicy(scopeType, scopeID, subjectType, subjectID, scalrClient)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(ap.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findAccessPolicy looks up the single access policy that binds
+// the given subject to the given scope.
+func findAccessPolicy(scopeType Scope, scopeID string, subjectType Subject, subjectID string, scalrClient *scalr.Client) (*scalr.AccessPolicy, error) {
+	options := scalr.AccessPolicyListOptions{}
+
+	switch scopeType {
+	case Workspace:
+		options.Workspace = &scopeID
+	case Environment:
+		options.Environment = &scopeID
+	case Account:
+		options.Account = &scopeID
+	}
+
+	switch subjectType {
+	case User:
+		options.User = &subjectID
+	case Team:
+		options.Team = &subjectID
+	case ServiceAccount:
+		options.ServiceAccount = &subjectID
+	}
+
+	var matched []*scalr.AccessPolicy
+	for {
+		apl, err := scalrClient.AccessPolicies.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access policies: %v", err)
+		}
+
+		// The filters may also match policies inherited from the upper scopes,
+		// so the scope and the subject are compared on our side as well.
+		for _, ap := range apl.Items {
+			if accessPolicyScopeID(ap, scopeType) == scopeID && accessPolicySubjectID(ap, subjectType) == subjectID {
+				matched = append(matched, ap)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if apl.CurrentPage >= apl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = apl.NextPage
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf(
+			"No access policy found for %s %s on %s %s", subjectType, subjectID, scopeType, scopeID)
+	case 1:
+		return matched[0], nil
+	default:
+		ids := make([]string, 0, len(matched))
+		for _, ap := range matched {
+			ids = append(ids, ap.ID)
+		}
+		return nil, fmt.Errorf(
+			"Found %d access policies for %s %s on %s %s: %s, import one of them by its ID",
+			len(matched), subjectType, subjectID, scopeType, scopeID, strings.Join(ids, ", "),
+		)
+	}
+}
+
+func accessPolicyScopeID(ap *scalr.AccessPolicy, scopeType Scope) string {
+	switch {
+	case scopeType == Workspace && ap.Workspace != nil:
+		return ap.Workspace.ID
+	case scopeType == Environment && ap.Environment != nil:
+		return ap.Environment.ID
+	case scopeType == Account && ap.Account != nil:
+		return ap.Account.ID
+	}
+	return ""
+}
+
+func accessPolicySubjectID(ap *scalr.AccessPolicy, subjectType Subject) string {
+	switch {
+	case subjectType == User && ap.User != nil:
+		return ap.User.ID
+	case subjectType == Team && ap.Team != nil:
+		return ap.Team.ID
+	case subjectType == ServiceAccount && ap.ServiceAccount != nil:
+		return ap.ServiceAccount.ID
+	}
+	return ""
+}
+
+func unpackAccessPolicyImportID(id string) (scopeType Scope, scopeID string, subjectType Subject, subjectID string, err error) {
+	s := strings.Split(id, "/")
+	if len(s) != 4 || s[0] == "" || s[1] == "" || s[2] == "" || s[3] == "" {
+		err = fmt.Errorf(
+			"invalid access policy import ID format: %s (expected <scope_type>/<scope_id>/<subject_type>/<subject_id>)", id,
+		)
+		return
+	}
+
+	scopeType, scopeID, subjectType, subjectID = Scope(s[0]), s[1], Subject(s[2]), s[3]
+
+	if scopeType.IsValid() != nil {
+		err = fmt.Errorf("scope type must be one of [workspace, environment, account], got: %s", scopeType)
+		return
+	}
+	if subjectType.IsValid() != nil {
+		err = fmt.Errorf("subject type must be one of [user, team, service_account], got: %s", subjectType)
+		return
+	}
+
+	return
+}
+
 func resourceScalrAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	scalrClient := meta.(*scalr.Client)
 
@@ -6,6 +6,7 @@ import (
 	"log"
 	"math/rand"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -171,10 +172,103 @@ func TestAccScalrAccessPolicy_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+
+			{
+				ResourceName:      "scalr_access_policy.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccScalrAccessPolicyImportID("scalr_access_policy.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestResourceScalrAccessPolicyImport(t *testing.T) {
+	client := testScalrClient(t)
+	for _, id := range []string{"ap-1", "ap-2", "ap-3"} {
+		client.AccessPolicies.Create(context.Background(), scalr.AccessPolicyCreateOptions{
+			ID:          id,
+			Roles:       []*scalr.Role{{ID: readOnlyRole}},
+			Team:        &scalr.Team{ID: "team-1"},
+			Environment: &scalr.Environment{ID: map[string]string{"ap-1": "env-1", "ap-2": "env-2", "ap-3": "env-2"}[id]},
+		})
+	}
+
+	tests := map[string]struct {
+		id   string
+		want string
+		err  string
+	}{
+		"access policy ID": {
+			id:   "ap-1",
+			want: "ap-1",
+		},
+		"single match": {
+			id:   "environment/env-1/team/team-1",
+			want: "ap-1",
+		},
+		"no match": {
+			id:  "environment/env-1/user/user-1",
+			err: "No access policy found for user user-1 on environment env-1",
+		},
+		"several matches": {
+			id:  "environment/env-2/team/team-1",
+			err: "Found 2 access policies for team team-1 on environment env-2",
+		},
+		"invalid format": {
+			id:  "environment/env-1/team-1",
+			err: "invalid access policy import ID format",
+		},
+		"invalid scope type": {
+			id:  "universe/env-1/team/team-1",
+			err: "scope type must be one of [workspace, environment, account], got: universe",
+		},
+		"invalid subject type": {
+			id:  "environment/env-1/grandpa/team-1",
+			err: "subject type must be one of [user, team, service_account], got: grandpa",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := resourceScalrAccessPolicy().TestResourceData()
+			d.SetId(test.id)
+
+			got, err := resourceScalrAccessPolicyImport(d, client)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got[0].Id() != test.want {
+				t.Fatalf("wrong result\ngot: %#v\nwant: %#v", got[0].Id(), test.want)
+			}
+		})
+	}
+}
+
+func testAccScalrAccessPolicyImportID(resId string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resId]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resId)
+		}
+
+		return fmt.Sprintf(
+			"%s/%s/%s/%s",
+			rs.Primary.Attributes["scope.0.type"],
+			rs.Primary.Attributes["scope.0.id"],
+			rs.Primary.Attributes["subject.0.type"],
+			rs.Primary.Attributes["subject.0.id"],
+		), nil
+	}
+}
+
 func testAccCheckScalrAccessPolicyExists(resId string, ap *scalr.AccessPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		scalrClient := testAccProvider.Meta().(*scalr.Client)
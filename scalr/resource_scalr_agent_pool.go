@@ -35,6 +35,14 @@ func resourceScalrAgentPool() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+
+			"workspace_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"agents": agentsSchema(),
 		},
 	}
 }
@@ -92,6 +100,15 @@ func resourceScalrAgentPoolRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		d.Set("environment_id", nil)
 	}
+
+	workspaceIDs := make([]interface{}, 0)
+	for _, ws := range agentPool.Workspaces {
+		workspaceIDs = append(workspaceIDs, ws.ID)
+	}
+	d.Set("workspace_ids", workspaceIDs)
+
+	d.Set("agents", flattenAgents(agentPool.Agents))
+
 	return nil
 }
 
@@ -101,13 +118,27 @@ func resourceScalrAgentPoolUpdate(d *schema.ResourceData, meta interface{}) erro
 	id := d.Id()
 
 	if d.HasChange("name") {
+		agentPoolMutexKV.Lock(id)
+		defer agentPoolMutexKV.Unlock(id)
+
+		// The workspaces are always sent on update, so the currently
+		// assigned ones are read right before it to preserve them.
+		agentPool, err := scalrClient.AgentPools.Read(ctx, id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving agent pool %s: %v", id, err)
+		}
+
+		workspaces := make([]*scalr.Workspace, 0, len(agentPool.Workspaces))
+		workspaces = append(workspaces, agentPool.Workspaces...)
+
 		// Create a new options struct
 		options := scalr.AgentPoolUpdateOptions{
-			Name: scalr.String(d.Get("name").(string)),
+			Name:       scalr.String(d.Get("name").(string)),
+			Workspaces: workspaces,
 		}
 
 		log.Printf("[DEBUG] Update agent pool %s", id)
-		_, err := scalrClient.AgentPools.Update(ctx, id, options)
+		_, err = scalrClient.AgentPools.Update(ctx, id, options)
 		if err != nil {
 			return fmt.Errorf(
 				"Error updating agentPool %s: %v", id, err)
@@ -28,6 +28,8 @@ func TestAccScalrAgentPool_basic(t *testing.T) {
 						"scalr_agent_pool.test", "name", fmt.Sprintf("agent_pool-test-%d", rInt),
 					),
 					resource.TestCheckResourceAttr("scalr_agent_pool.test", "account_id", defaultAccount),
+					resource.TestCheckResourceAttr("scalr_agent_pool.test", "workspace_ids.#", "0"),
+					resource.TestCheckResourceAttr("scalr_agent_pool.test", "agents.#", "0"),
 				),
 			},
 		},
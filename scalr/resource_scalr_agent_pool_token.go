@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	scalr "github.com/scalr/go-scalr"
@@ -15,6 +17,10 @@ func resourceScalrAgentPoolToken() *schema.Resource {
 		Read:          resourceScalrAgentPoolTokenRead,
 		Update:        resourceScalrAgentPoolTokenUpdate,
 		Delete:        resourceScalrAgentPoolTokenDelete,
+		CustomizeDiff: resourceScalrAgentPoolTokenCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceScalrAgentPoolTokenImport,
+		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -31,10 +37,113 @@ func resourceScalrAgentPoolToken() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					ttl, err := time.ParseDuration(val.(string))
+					if err != nil || ttl <= 0 {
+						errs = append(errs, fmt.Errorf("%s must be a positive duration, e.g. 720h, got: %s", key, val))
+					}
+					return
+				},
+			},
+			"rotation_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceScalrAgentPoolTokenCustomizeDiff recomputes the expiration time
+// and replaces the token once it has expired. Scalr doesn't expire the tokens
+// by itself, so the expiration only takes effect on the next apply.
+func resourceScalrAgentPoolTokenCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+	if err != nil {
+		// the creation time is unknown for tokens created by older versions
+		// of the provider until the next refresh.
+		return nil
+	}
+
+	expiresAt := tokenExpiresAt(createdAt, d.Get("ttl").(string))
+	if expiresAt == "" {
+		if d.Get("expires_at").(string) != "" {
+			return d.SetNew("expires_at", "")
+		}
+		return nil
+	}
+
+	if t, _ := time.Parse(time.RFC3339, expiresAt); !time.Now().Before(t) {
+		log.Printf("[DEBUG] Agent pool token %s expired at %s", d.Id(), expiresAt)
+		if err := d.SetNewComputed("expires_at"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("token"); err != nil {
+			return err
+		}
+		return d.ForceNew("expires_at")
+	}
+
+	if d.Get("expires_at").(string) != expiresAt {
+		return d.SetNew("expires_at", expiresAt)
+	}
+
+	return nil
+}
+
+// tokenExpiresAt returns the expiration time of the token
+// created at the given time, or an empty string if the token doesn't expire.
+func tokenExpiresAt(createdAt time.Time, ttl string) string {
+	if ttl == "" {
+		return ""
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return ""
+	}
+	return createdAt.Add(duration).UTC().Format(time.RFC3339)
+}
+
+func resourceScalrAgentPoolTokenImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	s := strings.SplitN(id, "/", 2)
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid agent pool token import ID format: %s (expected <agent_pool_id>/<token_id>)", id,
+		)
+	}
+
+	d.Set("agent_pool_id", s[0])
+	d.SetId(s[1])
+
+	// Importers can't return diagnostics in this SDK version,
+	// so the empty token is documented and only logged here.
+	log.Printf(
+		"[WARN] The value of the agent pool token %s cannot be recovered, "+
+			"the 'token' attribute stays empty after import. "+
+			"Replace the token to obtain its value.", s[1],
+	)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceScalrAgentPoolTokenCreate(d *schema.ResourceData, meta interface{}) error {
 	scalrClient := meta.(*scalr.Client)
 
@@ -68,7 +177,8 @@ func resourceScalrAgentPoolTokenRead(d *schema.ResourceData, meta interface{}) e
 	poolID := d.Get("agent_pool_id").(string)
 
 	if poolID == "" {
-		return fmt.Errorf("This resource does not support import")
+		return fmt.Errorf(
+			"Missing agent_pool_id of agent pool token %s, import it by <agent_pool_id>/<token_id>", id)
 	}
 
 	log.Printf("[DEBUG] Read configuration of agent pool token: %s", id)
@@ -89,6 +199,8 @@ func resourceScalrAgentPoolTokenRead(d *schema.ResourceData, meta interface{}) e
 		for _, t := range tokensList.Items {
 			if t.ID == id {
 				d.Set("description", t.Description)
+				d.Set("created_at", t.CreatedAt.UTC().Format(time.RFC3339))
+				d.Set("expires_at", tokenExpiresAt(t.CreatedAt, d.Get("ttl").(string)))
 				return nil
 			}
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -104,6 +105,185 @@ func TestAccScalrAgentPoolToken_update(t *testing.T) {
 	})
 }
 
+func TestAccScalrAgentPoolToken_import(t *testing.T) {
+	var pool scalr.AgentPool
+	if isAccTest() {
+		pool = createPool(t)
+		defer deletePool(t, pool)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckScalrAgentPoolTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAgentPoolTokenBasic(pool),
+			},
+
+			{
+				ResourceName:            "scalr_agent_pool_token.test",
+				ImportState:             true,
+				ImportStateIdPrefix:     pool.ID + "/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestAccScalrAgentPoolToken_ttl(t *testing.T) {
+	var pool scalr.AgentPool
+	if isAccTest() {
+		pool = createPool(t)
+		defer deletePool(t, pool)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckScalrAgentPoolTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAgentPoolTokenTTL(pool, "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_agent_pool_token.test", "ttl", "720h"),
+					resource.TestCheckResourceAttrSet("scalr_agent_pool_token.test", "created_at"),
+					resource.TestCheckResourceAttrSet("scalr_agent_pool_token.test", "expires_at"),
+					resource.TestCheckResourceAttr("scalr_agent_pool_token.test", "rotation_triggers.version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestTokenExpiresAt(t *testing.T) {
+	createdAt := time.Date(2022, 2, 16, 10, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		ttl  string
+		want string
+	}{
+		"no ttl":      {"", ""},
+		"invalid ttl": {"a month", ""},
+		"ttl":         {"720h", "2022-03-18T10:00:00Z"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tokenExpiresAt(createdAt, tc.ttl); got != tc.want {
+				t.Fatalf("wrong result\ngot: %#v\nwant: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResourceScalrAgentPoolTokenCustomizeDiff(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	created := now.Add(-time.Hour).Format(time.RFC3339)
+	createdLongAgo := now.Add(-48 * time.Hour).Format(time.RFC3339)
+
+	cases := map[string]struct {
+		createdAt   string
+		expiresAt   string
+		requiresNew bool
+		// expected new value of expires_at, nil if it should not be in the diff.
+		newExpiresAt *string
+	}{
+		"expired": {
+			createdAt:   createdLongAgo,
+			expiresAt:   now.Add(-24 * time.Hour).Format(time.RFC3339),
+			requiresNew: true,
+		},
+		"not expired": {
+			createdAt:    created,
+			expiresAt:    "",
+			newExpiresAt: scalr.String(now.Add(23 * time.Hour).Format(time.RFC3339)),
+		},
+		"missing created_at": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "at-123",
+				Attributes: map[string]string{
+					"id":            "at-123",
+					"description":   "token",
+					"agent_pool_id": "apool-123",
+					"ttl":           "24h",
+					"created_at":    tc.createdAt,
+					"expires_at":    tc.expiresAt,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"description":   "token",
+				"agent_pool_id": "apool-123",
+				"ttl":           "24h",
+			})
+
+			diff, err := resourceScalrAgentPoolToken().Diff(state, config, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff == nil {
+				diff = &terraform.InstanceDiff{}
+			}
+			if diff.RequiresNew() != tc.requiresNew {
+				t.Fatalf("expected requires new to be %t, got %v", tc.requiresNew, diff)
+			}
+			if tc.requiresNew {
+				return
+			}
+
+			attr := diff.Attributes["expires_at"]
+			if tc.newExpiresAt == nil {
+				if attr != nil {
+					t.Fatalf("expected no diff of expires_at, got %v", attr)
+				}
+				return
+			}
+			if attr == nil || attr.New != *tc.newExpiresAt {
+				t.Fatalf("expected expires_at to be %s, got %v", *tc.newExpiresAt, attr)
+			}
+		})
+	}
+}
+
+func TestResourceScalrAgentPoolTokenImport(t *testing.T) {
+	cases := map[string]struct {
+		id     string
+		poolID string
+		err    bool
+	}{
+		"pool and token": {"apool-123/at-123", "apool-123", false},
+		"token only":     {"at-123", "", true},
+		"empty token":    {"apool-123/", "", true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := resourceScalrAgentPoolToken().TestResourceData()
+			d.SetId(tc.id)
+
+			got, err := resourceScalrAgentPoolTokenImport(d, nil)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error is %t, got %v", tc.err, err)
+			}
+			if tc.err {
+				return
+			}
+
+			if got[0].Id() != "at-123" {
+				t.Fatalf("expected ID %q, got %q", "at-123", got[0].Id())
+			}
+			if got[0].Get("agent_pool_id").(string) != tc.poolID {
+				t.Fatalf("expected agent pool ID %q, got %q", tc.poolID, got[0].Get("agent_pool_id"))
+			}
+		})
+	}
+}
+
 func testAccCheckScalrAgentPoolTokenExists(resId string, pool scalr.AgentPool, token *scalr.AgentPoolToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		scalrClient := testAccProvider.Meta().(*scalr.Client)
@@ -230,3 +410,15 @@ resource "scalr_agent_pool_token" "test" {
   agent_pool_id     = "%s"
 }`, pool.ID)
 }
+
+func testAccScalrAgentPoolTokenTTL(pool scalr.AgentPool, ttl string) string {
+	return fmt.Sprintf(`
+resource "scalr_agent_pool_token" "test" {
+  description   = "agent_pool_token-test"
+  agent_pool_id = "%s"
+  ttl           = "%s"
+  rotation_triggers = {
+    version = "1"
+  }
+}`, pool.ID, ttl)
+}
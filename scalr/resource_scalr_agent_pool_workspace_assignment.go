@@ -0,0 +1,187 @@
+package scalr
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+)
+
+// agentPoolMutexKV serializes the updates of the agent pool workspaces,
+// as the whole relation is replaced on each update.
+var agentPoolMutexKV = mutexkv.NewMutexKV()
+
+func resourceScalrAgentPoolWorkspaceAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScalrAgentPoolWorkspaceAssignmentCreate,
+		Read:   resourceScalrAgentPoolWorkspaceAssignmentRead,
+		Delete: resourceScalrAgentPoolWorkspaceAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceScalrAgentPoolWorkspaceAssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceScalrAgentPoolWorkspaceAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	scalrClient := meta.(*scalr.Client)
+
+	id := d.Id()
+
+	agentPool, workspace, err := getAssignedResources(id, scalrClient)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return nil, fmt.Errorf("agent pool workspace assignment %s not found", id)
+		}
+		return nil, fmt.Errorf("error retrieving agent pool workspace assignment %s: %v", id, err)
+	}
+
+	d.Set("agent_pool_id", agentPool.ID)
+	d.Set("workspace_id", workspace.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceScalrAgentPoolWorkspaceAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	poolID := d.Get("agent_pool_id").(string)
+	wsID := d.Get("workspace_id").(string)
+	id := packAgentPoolWorkspaceAssignmentID(poolID, wsID)
+
+	agentPoolMutexKV.Lock(poolID)
+	defer agentPoolMutexKV.Unlock(poolID)
+
+	agentPool, err := scalrClient.AgentPools.Read(ctx, poolID)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return fmt.Errorf("agent pool %s not found", poolID)
+		}
+		return fmt.Errorf("error creating agent pool workspace assignment %s: %v", id, err)
+	}
+
+	// existing workspaces of the agent pool plus the new one
+	workspaces := append(agentPool.Workspaces, &scalr.Workspace{ID: wsID})
+
+	opts := scalr.AgentPoolUpdateOptions{Workspaces: workspaces}
+	_, err = scalrClient.AgentPools.Update(ctx, poolID, opts)
+	if err != nil {
+		return fmt.Errorf("error creating agent pool workspace assignment %s: %v", id, err)
+	}
+
+	d.SetId(id)
+	return resourceScalrAgentPoolWorkspaceAssignmentRead(d, meta)
+}
+
+func resourceScalrAgentPoolWorkspaceAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	id := d.Id()
+
+	agentPool, workspace, err := getAssignedResources(id, scalrClient)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			log.Printf("[DEBUG] Agent pool workspace assignment %s not found", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving agent pool workspace assignment %s: %v", id, err)
+	}
+
+	d.Set("agent_pool_id", agentPool.ID)
+	d.Set("workspace_id", workspace.ID)
+
+	return nil
+}
+
+func resourceScalrAgentPoolWorkspaceAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	id := d.Id()
+
+	poolID := d.Get("agent_pool_id").(string)
+	agentPoolMutexKV.Lock(poolID)
+	defer agentPoolMutexKV.Unlock(poolID)
+
+	agentPool, workspace, err := getAssignedResources(id, scalrClient)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			log.Printf("[DEBUG] Agent pool workspace assignment %s not found", id)
+			return nil
+		}
+		return fmt.Errorf("error deleting agent pool workspace assignment %s: %v", id, err)
+	}
+
+	// existing workspaces of the agent pool that will remain assigned
+	workspaces := make([]*scalr.Workspace, 0)
+	for _, ws := range agentPool.Workspaces {
+		if ws.ID != workspace.ID {
+			workspaces = append(workspaces, ws)
+		}
+	}
+
+	opts := scalr.AgentPoolUpdateOptions{Workspaces: workspaces}
+	_, err = scalrClient.AgentPools.Update(ctx, agentPool.ID, opts)
+	if err != nil {
+		return fmt.Errorf("error deleting agent pool workspace assignment %s: %v", id, err)
+	}
+
+	return nil
+}
+
+// getAssignedResources verifies existence of the assignment
+// and returns associated agent pool and workspace.
+func getAssignedResources(id string, scalrClient *scalr.Client) (
+	agentPool *scalr.AgentPool, workspace *scalr.Workspace, err error,
+) {
+	poolID, wsID, err := unpackAgentPoolWorkspaceAssignmentID(id)
+	if err != nil {
+		return
+	}
+
+	agentPool, err = scalrClient.AgentPools.Read(ctx, poolID)
+	if err != nil {
+		return
+	}
+
+	for _, ws := range agentPool.Workspaces {
+		if ws.ID == wsID {
+			workspace = ws
+			break
+		}
+	}
+	if workspace == nil {
+		return nil, nil, scalr.ErrResourceNotFound{}
+	}
+
+	return
+}
+
+func packAgentPoolWorkspaceAssignmentID(poolID, wsID string) string {
+	return poolID + "/" + wsID
+}
+
+func unpackAgentPoolWorkspaceAssignmentID(id string) (poolID, wsID string, err error) {
+	if s := strings.SplitN(id, "/", 2); len(s) == 2 {
+		return s[0], s[1], nil
+	}
+	return "", "", fmt.Errorf(
+		"invalid agent pool workspace assignment ID format: %s (expected <agent_pool_id>/<workspace_id>)", id,
+	)
+}
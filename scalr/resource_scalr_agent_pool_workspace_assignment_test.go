@@ -0,0 +1,124 @@
+package scalr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func TestAccScalrAgentPoolWorkspaceAssignment_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+	agentPool := &scalr.AgentPool{}
+	workspace := &scalr.Workspace{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckScalrAgentPoolWorkspaceAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAgentPoolWorkspaceAssignmentConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrAgentPoolWorkspaceAssignmentExists(
+						"scalr_agent_pool_workspace_assignment.test",
+						agentPool,
+						workspace,
+					),
+					resource.TestCheckResourceAttrPtr(
+						"scalr_agent_pool_workspace_assignment.test",
+						"agent_pool_id",
+						&agentPool.ID,
+					),
+					resource.TestCheckResourceAttrPtr(
+						"scalr_agent_pool_workspace_assignment.test",
+						"workspace_id",
+						&workspace.ID,
+					),
+				),
+			},
+			{
+				ResourceName:      "scalr_agent_pool_workspace_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrAgentPoolWorkspaceAssignmentExists(
+	resID string,
+	agentPool *scalr.AgentPool,
+	workspace *scalr.Workspace,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := testAccProvider.Meta().(*scalr.Client)
+
+		rs, ok := s.RootModule().Resources[resID]
+		if !ok {
+			return fmt.Errorf("not found: %s", resID)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		pool, ws, err := getAssignedResources(rs.Primary.ID, scalrClient)
+		if err != nil {
+			return err
+		}
+
+		*agentPool = *pool
+		*workspace = *ws
+
+		return nil
+	}
+}
+
+func testAccCheckScalrAgentPoolWorkspaceAssignmentDestroy(s *terraform.State) error {
+	scalrClient := testAccProvider.Meta().(*scalr.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_agent_pool_workspace_assignment" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		_, _, err := getAssignedResources(rs.Primary.ID, scalrClient)
+		if err == nil {
+			return fmt.Errorf("agent pool workspace assignment %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrAgentPoolWorkspaceAssignmentConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "agent_pool-test-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource "scalr_agent_pool" "test" {
+  name           = "agent_pool-test-%[1]d"
+  account_id     = "%[2]s"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_workspace" "test" {
+  name           = "agent_pool-test-%[1]d"
+  environment_id = scalr_environment.test.id
+  operations     = false
+}
+
+resource "scalr_agent_pool_workspace_assignment" "test" {
+  agent_pool_id = scalr_agent_pool.test.id
+  workspace_id  = scalr_workspace.test.id
+}`, rInt, defaultAccount)
+}
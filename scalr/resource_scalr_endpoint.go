@@ -59,7 +59,16 @@ func resourceScalrEndpoint() *schema.Resource {
 
 			"environment_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -73,8 +82,9 @@ func resourceScalrEndpointCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Get scope
 	environmentID := d.Get("environment_id").(string)
+	accountID := d.Get("account_id").(string)
 	// we don't create endpoints on workspace scope for now
-	_, environment, account, err := getResourceScope(scalrClient, "", environmentID)
+	_, environment, account, err := resolveResourceScope(scalrClient, "", environmentID, accountID)
 	if err != nil {
 		return err
 	}
@@ -131,6 +141,9 @@ func resourceScalrEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	if endpoint.Environment != nil {
 		d.Set("environment_id", endpoint.Environment.ID)
 	}
+	if endpoint.Account != nil {
+		d.Set("account_id", endpoint.Account.ID)
+	}
 	d.SetId(endpointID)
 
 	return nil
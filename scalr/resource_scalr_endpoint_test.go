@@ -78,6 +78,29 @@ func TestAccEndpoint_update(t *testing.T) {
 	})
 }
 
+func TestAccEndpoint_account(t *testing.T) {
+	rInt := GetRandomInteger()
+	secretKey := "strong_key_with_UPPERCASE_letter_at_least_1_number"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAccountConfig(rInt, secretKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"scalr_endpoint.test", "name", fmt.Sprintf("test endpoint-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"scalr_endpoint.test", "account_id", defaultAccount),
+					resource.TestCheckResourceAttr(
+						"scalr_endpoint.test", "environment_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccEndpointConfig(rInt int, secretKey string) string {
 	return fmt.Sprintf(`
 resource scalr_environment test {
@@ -111,3 +134,15 @@ resource scalr_endpoint test {
   environment_id = scalr_environment.test.id
 }`, rInt, defaultAccount, secretKey)
 }
+
+func testAccEndpointAccountConfig(rInt int, secretKey string) string {
+	return fmt.Sprintf(`
+resource scalr_endpoint test {
+  name         = "test endpoint-%[1]d"
+  secret_key   = "%[3]s"
+  timeout      = 15
+  max_attempts = 3
+  url          = "https://example.com/endpoint"
+  account_id   = "%[2]s"
+}`, rInt, defaultAccount, secretKey)
+}
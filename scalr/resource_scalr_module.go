@@ -75,6 +75,30 @@ func resourceScalrModule() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -135,7 +159,7 @@ func resourceScalrModuleRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Update the config.
 	d.Set("name", m.Name)
-	d.Set("provider", m.Provider)
+	d.Set("module_provider", m.Provider)
 	d.Set("status", m.Status)
 	d.Set("source", m.Source)
 	d.Set("vcs_repo", []map[string]interface{}{{
@@ -152,9 +176,54 @@ func resourceScalrModuleRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("environment_id", m.Environment.ID)
 	}
 
+	versions, err := getModuleVersions(scalrClient, id)
+	if err != nil {
+		return err
+	}
+
+	var latestVersion string
+	flattened := make([]map[string]interface{}, 0, len(versions))
+	for _, mv := range versions {
+		if m.LatestModuleVersion != nil && mv.ID == m.LatestModuleVersion.ID {
+			latestVersion = mv.Version
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"id":      mv.ID,
+			"version": mv.Version,
+			"status":  string(mv.Status),
+		})
+	}
+	d.Set("latest_version", latestVersion)
+	d.Set("versions", flattened)
+
 	return nil
 }
 
+// getModuleVersions returns all the versions of the module.
+func getModuleVersions(scalrClient *scalr.Client, moduleID string) ([]*scalr.ModuleVersion, error) {
+	versions := make([]*scalr.ModuleVersion, 0)
+	options := scalr.ModuleVersionListOptions{Module: moduleID}
+
+	for {
+		mvl, err := scalrClient.ModuleVersions.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving versions of module %s: %v", moduleID, err)
+		}
+
+		versions = append(versions, mvl.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if mvl.CurrentPage >= mvl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = mvl.NextPage
+	}
+
+	return versions, nil
+}
+
 func resourceScalrModuleDelete(d *schema.ResourceData, meta interface{}) error {
 	scalrClient := meta.(*scalr.Client)
 	id := d.Id()
@@ -27,6 +27,7 @@ func TestAccScalrModule_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("scalr_module.test", "account_id", defaultAccount),
 					resource.TestCheckResourceAttrSet("scalr_module.test", "environment_id"),
 					resource.TestCheckResourceAttr("scalr_module.test", "vcs_repo.0.identifier", "Scalr/terraform-scalr-revizor"),
+					resource.TestCheckResourceAttrSet("scalr_module.test", "versions.#"),
 
 					testAccCheckScalrModuleExists("scalr_module.test-account", &scalr.Module{}),
 					resource.TestCheckResourceAttr("scalr_module.test-account", "account_id", defaultAccount),
@@ -14,6 +14,10 @@ func resourceScalrRunTrigger() *schema.Resource {
 		Create: resourceScalrRunTriggerCreate,
 		Delete: resourceScalrRunTriggerDelete,
 		Read:   resourceScalrRunTriggerRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceScalrRunTriggerCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"downstream_id": {
@@ -30,6 +34,59 @@ func resourceScalrRunTrigger() *schema.Resource {
 	}
 }
 
+// resourceScalrRunTriggerCustomizeDiff rejects run triggers that would
+// run a workspace after itself or that connect workspaces of different accounts.
+func resourceScalrRunTriggerCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("upstream_id") && !d.HasChange("downstream_id") {
+		return nil
+	}
+	if !d.NewValueKnown("upstream_id") || !d.NewValueKnown("downstream_id") {
+		return nil
+	}
+
+	return validateRunTriggerWorkspaces(
+		meta.(*scalr.Client), d.Get("upstream_id").(string), d.Get("downstream_id").(string),
+	)
+}
+
+func validateRunTriggerWorkspaces(scalrClient *scalr.Client, upstreamID, downstreamID string) error {
+	if upstreamID == downstreamID {
+		return fmt.Errorf("Run trigger would create a cycle: workspace %s cannot be its own upstream", upstreamID)
+	}
+
+	upstream, err := scalrClient.Workspaces.ReadByID(ctx, upstreamID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving upstream workspace %s: %v", upstreamID, err)
+	}
+	downstream, err := scalrClient.Workspaces.ReadByID(ctx, downstreamID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving downstream workspace %s: %v", downstreamID, err)
+	}
+
+	// Workspaces of the same environment are always in the same account.
+	if upstream.Environment.ID == downstream.Environment.ID {
+		return nil
+	}
+
+	upstreamEnv, err := scalrClient.Environments.Read(ctx, upstream.Environment.ID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving environment %s: %v", upstream.Environment.ID, err)
+	}
+	downstreamEnv, err := scalrClient.Environments.Read(ctx, downstream.Environment.ID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving environment %s: %v", downstream.Environment.ID, err)
+	}
+
+	if upstreamEnv.Account.ID != downstreamEnv.Account.ID {
+		return fmt.Errorf(
+			"Run trigger workspaces must be in the same account: upstream %s is in account %s, downstream %s is in account %s",
+			upstreamID, upstreamEnv.Account.ID, downstreamID, downstreamEnv.Account.ID,
+		)
+	}
+
+	return nil
+}
+
 func resourceScalrRunTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	scalrClient := meta.(*scalr.Client)
 
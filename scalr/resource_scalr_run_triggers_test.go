@@ -1,7 +1,9 @@
 package scalr
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -29,6 +31,68 @@ func TestAccScalrRunTriggersDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccScalrRunTrigger_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRunTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTrigger_basic(rInt),
+			},
+			{
+				ResourceName:      "scalr_run_trigger.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateRunTriggerWorkspaces(t *testing.T) {
+	client := testScalrClient(t)
+	for _, env := range []struct{ id, account string }{{"env-1", "acc-1"}, {"env-2", "acc-2"}, {"env-3", "acc-1"}} {
+		client.Environments.Create(context.Background(), scalr.EnvironmentCreateOptions{
+			ID:      env.id,
+			Name:    scalr.String(env.id),
+			Account: &scalr.Account{ID: env.account},
+		})
+	}
+	for _, ws := range []struct{ id, env string }{{"ws-1", "env-1"}, {"ws-2", "env-1"}, {"ws-3", "env-2"}, {"ws-4", "env-3"}} {
+		client.Workspaces.Create(context.Background(), scalr.WorkspaceCreateOptions{
+			ID:          ws.id,
+			Name:        scalr.String(ws.id),
+			Environment: &scalr.Environment{ID: ws.env},
+		})
+	}
+
+	cases := map[string]struct {
+		upstreamID, downstreamID string
+		err                      string
+	}{
+		"same environment":      {"ws-1", "ws-2", ""},
+		"same account":          {"ws-1", "ws-4", ""},
+		"self trigger":          {"ws-1", "ws-1", "workspace ws-1 cannot be its own upstream"},
+		"different accounts":    {"ws-1", "ws-3", "upstream ws-1 is in account acc-1, downstream ws-3 is in account acc-2"},
+		"non existing upstream": {"ws-unknown", "ws-1", "Error retrieving upstream workspace"},
+	}
+
+	for name, c := range cases {
+		err := validateRunTriggerWorkspaces(client, c.upstreamID, c.downstreamID)
+		if c.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: expected error containing %q, got %v", name, c.err, err)
+		}
+	}
+}
+
 func testAccCheckRunTriggerDestroy(s *terraform.State) error {
 	scalrClient := testAccProvider.Meta().(*scalr.Client)
 
@@ -4,17 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	scalr "github.com/scalr/go-scalr"
+	"github.com/scalr/terraform-provider-scalr/webhook"
 )
 
 var (
 	eventDefinitions = map[string]bool{
-		"run:completed":       true,
-		"run:errored":         true,
-		"run:needs_attention": true,
+		webhook.EventRunCompleted:      true,
+		webhook.EventRunErrored:        true,
+		webhook.EventRunNeedsAttention: true,
 	}
 )
 
@@ -46,8 +48,11 @@ func resourceScalrWebhook() *schema.Resource {
 			},
 
 			"events": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateEventDefinition,
+				},
 				Required: true,
 			},
 
@@ -66,51 +71,66 @@ func resourceScalrWebhook() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
 
-//remove after https://scalr-labs.atlassian.net/browse/SCALRCORE-16234
-func getResourceScope(scalrClient *scalr.Client, workspaceID string, environmentID string) (*scalr.Workspace, *scalr.Environment, *scalr.Account, error) {
-
-	// Resource scope
-	var workspace *scalr.Workspace
-	var environment *scalr.Environment
-	var account *scalr.Account
+// resolveResourceScope returns the scope relations of a webhook or an endpoint.
+// The missing IDs are taken from the parent relations, and the given IDs
+// are checked to belong to each other.
+func resolveResourceScope(scalrClient *scalr.Client, workspaceID, environmentID, accountID string) (
+	workspace *scalr.Workspace, environment *scalr.Environment, account *scalr.Account, err error,
+) {
+	if workspaceID == "" && environmentID == "" && accountID == "" {
+		return nil, nil, nil, errors.New("Missing workspace_id, environment_id or account_id")
+	}
 
-	// Get the workspace.
 	if workspaceID != "" {
-		var err error
-		workspace, err = scalrClient.Workspaces.ReadByID(ctx, workspaceID)
+		ws, err := scalrClient.Workspaces.ReadByID(ctx, workspaceID)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("Error retrieving workspace %s: %v", workspaceID, err)
 		}
-
-		if environmentID != "" && environmentID != workspace.Environment.ID {
+		if environmentID != "" && environmentID != ws.Environment.ID {
 			return nil, nil, nil, fmt.Errorf("Workspace %s does not belong to an environment %s", workspaceID, environmentID)
 		}
-
-		environmentID = workspace.Environment.ID
+		environmentID = ws.Environment.ID
+		workspace = &scalr.Workspace{ID: workspaceID}
 	}
 
-	// Get the environment.
 	if environmentID != "" {
-		var err error
-		environment, err = scalrClient.Environments.Read(ctx, environmentID)
+		env, err := scalrClient.Environments.Read(ctx, environmentID)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("Error retrieving environment %s: %v", environmentID, err)
 		}
-		account = environment.Account
-	} else {
-		return nil, nil, nil, fmt.Errorf("Missing workspace_id or environment_id")
+		if accountID != "" && accountID != env.Account.ID {
+			return nil, nil, nil, fmt.Errorf("Environment %s does not belong to an account %s", environmentID, accountID)
+		}
+		accountID = env.Account.ID
+		environment = &scalr.Environment{ID: environmentID}
 	}
 
+	account = &scalr.Account{ID: accountID}
+
 	return workspace, environment, account, nil
 }
 
-func validateEventDefinitions(eventName string) error {
+// validateEventDefinition warns about events unknown to the provider,
+// the final validation is left to the API, so newly added events can be used
+// without upgrading the provider.
+func validateEventDefinition(val interface{}, key string) (warns []string, errs []error) {
+	eventName := val.(string)
+	if eventName == "" {
+		return
+	}
 	if val, ok := eventDefinitions[eventName]; ok && val {
-		return nil
+		return
 	}
 	i := 0
 	eventDefinitionsQuoted := make([]string, len(eventDefinitions))
@@ -118,8 +138,10 @@ func validateEventDefinitions(eventName string) error {
 		eventDefinitionsQuoted[i] = fmt.Sprintf("'%s'", eventDefinition)
 		i++
 	}
-	return fmt.Errorf(
-		"Invalid value for events '%s'. Allowed values: %s", eventName, strings.Join(eventDefinitionsQuoted, ", "))
+	sort.Strings(eventDefinitionsQuoted)
+	warns = append(warns, fmt.Sprintf(
+		"%s: event '%s' is not known to the provider. Known values: %s", key, eventName, strings.Join(eventDefinitionsQuoted, ", ")))
+	return
 }
 
 func parseEventDefinitions(d *schema.ResourceData) ([]*scalr.EventDefinition, error) {
@@ -132,11 +154,7 @@ func parseEventDefinitions(d *schema.ResourceData) ([]*scalr.EventDefinition, er
 	}
 
 	for _, eventID := range eventIds {
-		id := eventID.(string)
-		if err := validateEventDefinitions(id); err != nil {
-			return nil, err
-		}
-		eventDefinitions = append(eventDefinitions, &scalr.EventDefinition{ID: id})
+		eventDefinitions = append(eventDefinitions, &scalr.EventDefinition{ID: eventID.(string)})
 	}
 
 	return eventDefinitions, nil
@@ -150,8 +168,9 @@ func resourceScalrWebhookCreate(d *schema.ResourceData, meta interface{}) error
 	endpointID := d.Get("endpoint_id").(string)
 	workspaceID := d.Get("workspace_id").(string)
 	environmentID := d.Get("environment_id").(string)
+	accountID := d.Get("account_id").(string)
 
-	workspace, environment, account, err := getResourceScope(scalrClient, workspaceID, environmentID)
+	workspace, environment, account, err := resolveResourceScope(scalrClient, workspaceID, environmentID, accountID)
 	if err != nil {
 		return err
 	}
@@ -173,12 +192,12 @@ func resourceScalrWebhookCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Create webhook: %s", name)
-	webhook, err := scalrClient.Webhooks.Create(ctx, options)
+	wh, err := scalrClient.Webhooks.Create(ctx, options)
 	if err != nil {
 		return fmt.Errorf("Error creating webhook %s: %v", name, err)
 	}
 
-	d.SetId(webhook.ID)
+	d.SetId(wh.ID)
 
 	return resourceScalrWebhookRead(d, meta)
 }
@@ -190,7 +209,7 @@ func resourceScalrWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	webhookID := d.Id()
 
 	log.Printf("[DEBUG] Read endpoint with ID: %s", webhookID)
-	webhook, err := scalrClient.Webhooks.Read(ctx, webhookID)
+	wh, err := scalrClient.Webhooks.Read(ctx, webhookID)
 	if err != nil {
 		if errors.Is(err, scalr.ErrResourceNotFound{}) {
 			return fmt.Errorf("Could not find webhook %s: %v", webhookID, err)
@@ -199,26 +218,29 @@ func resourceScalrWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Update the config.
-	d.Set("name", webhook.Name)
-	d.Set("enabled", webhook.Enabled)
-	d.Set("last_triggered_at", webhook.LastTriggeredAt)
+	d.Set("name", wh.Name)
+	d.Set("enabled", wh.Enabled)
+	d.Set("last_triggered_at", wh.LastTriggeredAt)
 
 	events := []string{}
-	if webhook.Events != nil {
-		for _, event := range webhook.Events {
+	if wh.Events != nil {
+		for _, event := range wh.Events {
 			events = append(events, event.ID)
 		}
 	}
 	d.Set("events", events)
 
-	if webhook.Workspace != nil {
-		d.Set("workspace_id", webhook.Workspace.ID)
+	if wh.Workspace != nil {
+		d.Set("workspace_id", wh.Workspace.ID)
+	}
+	if wh.Environment != nil {
+		d.Set("environment_id", wh.Environment.ID)
 	}
-	if webhook.Environment != nil {
-		d.Set("environment_id", webhook.Environment.ID)
+	if wh.Account != nil {
+		d.Set("account_id", wh.Account.ID)
 	}
-	if webhook.Endpoint != nil {
-		d.Set("endpoint_id", webhook.Endpoint.ID)
+	if wh.Endpoint != nil {
+		d.Set("endpoint_id", wh.Endpoint.ID)
 	}
 
 	return nil
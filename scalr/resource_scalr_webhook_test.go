@@ -1,11 +1,13 @@
 package scalr
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	scalr "github.com/scalr/go-scalr"
 )
 
 func TestAccWebhook_basic(t *testing.T) {
@@ -73,6 +75,165 @@ func TestAccWebhook_update(t *testing.T) {
 	})
 }
 
+func TestAccWebhook_account(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookAccountConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.scalr_webhook.test", "name", fmt.Sprintf("webhook-test-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"data.scalr_webhook.test", "account_id", defaultAccount),
+					resource.TestCheckResourceAttr(
+						"data.scalr_webhook.test", "environment_id", ""),
+					resource.TestCheckResourceAttr(
+						"data.scalr_webhook.test", "workspace_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateEventDefinition(t *testing.T) {
+	cases := map[string]struct {
+		event string
+		warns int
+	}{
+		"known event":   {"run:completed", 0},
+		"unknown event": {"run:something_new", 1},
+		"empty event":   {"", 0},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			warns, errs := validateEventDefinition(tc.event, "events.0")
+			if len(errs) != 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if len(warns) != tc.warns {
+				t.Fatalf("expected %d warnings, got %v", tc.warns, warns)
+			}
+		})
+	}
+}
+
+func TestResolveResourceScope(t *testing.T) {
+	client := testScalrClient(t)
+	client.Environments.Create(context.Background(), scalr.EnvironmentCreateOptions{
+		ID:      "env-123",
+		Name:    scalr.String("an-environment"),
+		Account: &scalr.Account{ID: "acc-123"},
+	})
+	client.Workspaces.Create(context.Background(), scalr.WorkspaceCreateOptions{
+		ID:          "ws-123",
+		Name:        scalr.String("a-workspace"),
+		Environment: &scalr.Environment{ID: "env-123"},
+	})
+
+	cases := map[string]struct {
+		workspaceID, environmentID, accountID string
+		wantWorkspace, wantEnvironment        string
+		wantAccount                           string
+		err                                   bool
+	}{
+		"workspace": {
+			workspaceID:     "ws-123",
+			wantWorkspace:   "ws-123",
+			wantEnvironment: "env-123",
+			wantAccount:     "acc-123",
+		},
+		"environment": {
+			environmentID:   "env-123",
+			wantEnvironment: "env-123",
+			wantAccount:     "acc-123",
+		},
+		"account": {
+			accountID:   "acc-123",
+			wantAccount: "acc-123",
+		},
+		"fully specified scope": {
+			workspaceID:     "ws-123",
+			environmentID:   "env-123",
+			accountID:       "acc-123",
+			wantWorkspace:   "ws-123",
+			wantEnvironment: "env-123",
+			wantAccount:     "acc-123",
+		},
+		"workspace from another environment": {
+			workspaceID:   "ws-123",
+			environmentID: "env-other",
+			err:           true,
+		},
+		"environment from another account": {
+			environmentID: "env-123",
+			accountID:     "acc-other",
+			err:           true,
+		},
+		"non existing environment": {
+			environmentID: "env-unknown",
+			err:           true,
+		},
+		"empty scope": {
+			err: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ws, env, acc, err := resolveResourceScope(client, tc.workspaceID, tc.environmentID, tc.accountID)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error is %t, got %v", tc.err, err)
+			}
+			if tc.err {
+				return
+			}
+
+			if (ws == nil && tc.wantWorkspace != "") || (ws != nil && ws.ID != tc.wantWorkspace) {
+				t.Fatalf("expected workspace %q, got %#v", tc.wantWorkspace, ws)
+			}
+			if (env == nil && tc.wantEnvironment != "") || (env != nil && env.ID != tc.wantEnvironment) {
+				t.Fatalf("expected environment %q, got %#v", tc.wantEnvironment, env)
+			}
+			if acc.ID != tc.wantAccount {
+				t.Fatalf("expected account %q, got %q", tc.wantAccount, acc.ID)
+			}
+		})
+	}
+}
+
+func testAccWebhookAccountConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-%[1]d"
+  account_id = "%s"
+}
+
+resource scalr_endpoint test {
+  name         = "test endpoint-%[1]d"
+  timeout      = 15
+  max_attempts = 3
+  url          = "https://example.com/webhook"
+  environment_id = scalr_environment.test.id
+}
+
+resource scalr_webhook test {
+  enabled     = false
+  name        = "webhook-test-%[1]d"
+  events      = ["run:completed", "run:errored"]
+  endpoint_id = scalr_endpoint.test.id
+  account_id  = scalr_environment.test.account_id
+}
+
+data scalr_webhook test {
+  id         = scalr_webhook.test.id
+}`, rInt, defaultAccount)
+}
+
 func testAccWebhookConfig(rInt int) string {
 	return fmt.Sprintf(`
 resource scalr_environment test {
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
+	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	scalr "github.com/scalr/go-scalr"
 )
@@ -18,6 +20,7 @@ func resourceScalrWorkspace() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceScalrWorkspaceCustomizeDiff,
 
 		SchemaVersion: 3,
 		StateUpgraders: []schema.StateUpgrader{
@@ -53,12 +56,35 @@ func resourceScalrWorkspace() *schema.Resource {
 			"vcs_provider_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"module_version_id"},
+				ConflictsWith: []string{"module_version_id", "module_version_constraint"},
 			},
 			"module_version_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"vcs_provider_id", "vcs_repo"},
+				ConflictsWith: []string{"vcs_provider_id", "vcs_repo", "module_version_constraint"},
+			},
+			"module_version_constraint": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"vcs_provider_id", "vcs_repo", "module_version_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateVersionConstraint,
+						},
+					},
+				},
+			},
+			"resolved_module_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"agent_pool_id": {
 				Type:     schema.TypeString,
@@ -78,9 +104,10 @@ func resourceScalrWorkspace() *schema.Resource {
 			},
 
 			"terraform_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTerraformVersion,
 			},
 
 			"working_directory": {
@@ -92,30 +119,35 @@ func resourceScalrWorkspace() *schema.Resource {
 			"hooks": {
 				Type:     schema.TypeList,
 				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"pre_plan": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							DiffSuppressFunc: suppressHookWhitespaceDiff,
 						},
 
 						"post_plan": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							DiffSuppressFunc: suppressHookWhitespaceDiff,
 						},
 
 						"pre_apply": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							DiffSuppressFunc: suppressHookWhitespaceDiff,
 						},
 
 						"post_apply": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							DiffSuppressFunc: suppressHookWhitespaceDiff,
 						},
 					},
 				},
@@ -131,7 +163,7 @@ func resourceScalrWorkspace() *schema.Resource {
 				Optional:      true,
 				MinItems:      1,
 				MaxItems:      1,
-				ConflictsWith: []string{"module_version_id"},
+				ConflictsWith: []string{"module_version_id", "module_version_constraint"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"identifier": {
@@ -191,6 +223,99 @@ func resourceScalrWorkspace() *schema.Resource {
 	}
 }
 
+func validateVersionConstraint(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if _, err := version.NewConstraint(v); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a valid version constraint, got %q: %v", key, v, err))
+	}
+	return
+}
+
+// suppressHookWhitespaceDiff ignores the leading and trailing whitespace of the hooks,
+// as the API strips it.
+func suppressHookWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// validateTerraformVersion rejects values that are not a concrete version,
+// whether it is available is still checked by the API.
+func validateTerraformVersion(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if _, err := version.NewSemver(v); err != nil {
+		if _, cerr := version.NewConstraint(v); cerr == nil {
+			errs = append(errs, fmt.Errorf("%s must be a concrete version such as 1.1.7, version constraints are not supported: %q", key, v))
+		} else {
+			errs = append(errs, fmt.Errorf("%s must be a valid version such as 1.1.7, got: %q", key, v))
+		}
+		return
+	}
+
+	// go-version also accepts a "v" prefix and any number of segments,
+	// which are not valid Terraform versions.
+	core := strings.SplitN(strings.SplitN(v, "+", 2)[0], "-", 2)[0]
+	if strings.HasPrefix(v, "v") || len(strings.Split(core, ".")) != 3 {
+		errs = append(errs, fmt.Errorf("%s must be a full version such as 1.1.7, got: %q", key, v))
+	}
+	return
+}
+
+// resourceScalrWorkspaceCustomizeDiff resolves the module version constraint
+// to the newest matching module version, so that a newly published match shows up as a diff.
+func resourceScalrWorkspaceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("module_version_constraint")
+	if !ok {
+		if d.Get("resolved_module_version_id").(string) != "" {
+			return d.SetNew("resolved_module_version_id", "")
+		}
+		return nil
+	}
+
+	if !d.NewValueKnown("module_version_constraint") {
+		return d.SetNewComputed("resolved_module_version_id")
+	}
+
+	constraint := v.([]interface{})[0].(map[string]interface{})
+	source := constraint["source"].(string)
+	if source == "" {
+		return d.SetNewComputed("resolved_module_version_id")
+	}
+
+	mv, err := resolveWorkspaceModuleVersion(meta.(*scalr.Client), source, constraint["version"].(string))
+	if err != nil {
+		return err
+	}
+
+	if mv.ID != d.Get("resolved_module_version_id").(string) {
+		log.Printf("[DEBUG] Module version constraint of workspace %s resolved to %s", d.Id(), mv.Version)
+		return d.SetNew("resolved_module_version_id", mv.ID)
+	}
+
+	return nil
+}
+
+// resolveWorkspaceModuleVersion returns the newest version of the module
+// with the given source that matches the version constraint.
+func resolveWorkspaceModuleVersion(scalrClient *scalr.Client, source, constraint string) (*scalr.ModuleVersion, error) {
+	module, err := scalrClient.Modules.ReadBySource(ctx, source)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return nil, fmt.Errorf("Could not find module with source %s", source)
+		}
+		return nil, fmt.Errorf("Error retrieving module: %v", err)
+	}
+
+	return resolveModuleVersionConstraint(scalrClient, module.ID, constraint, false)
+}
+
+// getWorkspaceModuleVersionID returns the module version to run the workspace from:
+// either the one set explicitly or the one resolved from the version constraint.
+func getWorkspaceModuleVersionID(d *schema.ResourceData) string {
+	if _, ok := d.GetOk("module_version_constraint"); ok {
+		return d.Get("resolved_module_version_id").(string)
+	}
+	return d.Get("module_version_id").(string)
+}
+
 func parseTriggerPrefixDefinitions(vcsRepo map[string]interface{}) ([]string, error) {
 	triggerPrefixes := make([]string, 0)
 
@@ -232,8 +357,8 @@ func resourceScalrWorkspaceCreate(d *schema.ResourceData, meta interface{}) erro
 		options.WorkingDirectory = scalr.String(workingDir.(string))
 	}
 
-	if v, ok := d.GetOk("module_version_id"); ok {
-		options.ModuleVersion = &scalr.ModuleVersion{ID: v.(string)}
+	if mvID := getWorkspaceModuleVersionID(d); mvID != "" {
+		options.ModuleVersion = &scalr.ModuleVersion{ID: mvID}
 	}
 
 	if vcsProviderID, ok := d.GetOk("vcs_provider_id"); ok {
@@ -320,15 +445,22 @@ func resourceScalrWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("vcs_provider_id", workspace.VcsProvider.ID)
 	}
 
+	var agentPoolID string
 	if workspace.AgentPool != nil {
-		d.Set("agent_pool_id", workspace.AgentPool.ID)
+		agentPoolID = workspace.AgentPool.ID
 	}
+	d.Set("agent_pool_id", agentPoolID)
 
 	var mv string
 	if workspace.ModuleVersion != nil {
 		mv = workspace.ModuleVersion.ID
 	}
-	d.Set("module_version_id", mv)
+	if _, ok := d.GetOk("module_version_constraint"); ok {
+		d.Set("resolved_module_version_id", mv)
+	} else {
+		d.Set("module_version_id", mv)
+		d.Set("resolved_module_version_id", "")
+	}
 
 	var createdBy []interface{}
 	if workspace.CreatedBy != nil {
@@ -375,7 +507,8 @@ func resourceScalrWorkspaceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.HasChange("terraform_version") || d.HasChange("working_directory") ||
 		d.HasChange("vcs_repo") || d.HasChange("operations") ||
 		d.HasChange("vcs_provider_id") || d.HasChange("agent_pool_id") ||
-		d.HasChange("hooks") || d.HasChange("module_version_id") {
+		d.HasChange("hooks") || d.HasChange("module_version_id") ||
+		d.HasChange("resolved_module_version_id") {
 		// Create a new options struct.
 		options := scalr.WorkspaceUpdateOptions{
 			Name:       scalr.String(d.Get("name").(string)),
@@ -439,9 +572,9 @@ func resourceScalrWorkspaceUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 
-		if v, ok := d.GetOk("module_version_id"); ok {
+		if mvID := getWorkspaceModuleVersionID(d); mvID != "" {
 			options.ModuleVersion = &scalr.ModuleVersion{
-				ID: v.(string),
+				ID: mvID,
 			}
 		}
 
@@ -0,0 +1,345 @@
+package scalr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func resourceScalrWorkspaceFromTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceScalrWorkspaceFromTemplateCreate,
+		Read:          resourceScalrWorkspaceFromTemplateRead,
+		Update:        resourceScalrWorkspaceFromTemplateUpdate,
+		Delete:        resourceScalrWorkspaceDelete,
+		CustomizeDiff: resourceScalrWorkspaceFromTemplateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"source_workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Overrides of the template settings.
+			"auto_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"terraform_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTerraformVersion,
+			},
+			"working_directory": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"template": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"inherited": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// workspaceTemplateSettings returns the settings of the workspace that are copied from the template.
+// The trigger prefixes are stored as a JSON list, as they can contain any character.
+func workspaceTemplateSettings(ws *scalr.Workspace) map[string]string {
+	s := map[string]string{
+		"auto_apply":        strconv.FormatBool(ws.AutoApply),
+		"operations":        strconv.FormatBool(ws.Operations),
+		"terraform_version": ws.TerraformVersion,
+		"working_directory": ws.WorkingDirectory,
+		"agent_pool_id":     "",
+		"vcs_provider_id":   "",
+		"module_version_id": "",
+	}
+	if ws.AgentPool != nil {
+		s["agent_pool_id"] = ws.AgentPool.ID
+	}
+	if ws.VcsProvider != nil {
+		s["vcs_provider_id"] = ws.VcsProvider.ID
+	}
+	if ws.ModuleVersion != nil {
+		s["module_version_id"] = ws.ModuleVersion.ID
+	}
+	if ws.VCSRepo != nil {
+		s["vcs_repo_identifier"] = ws.VCSRepo.Identifier
+		s["vcs_repo_branch"] = ws.VCSRepo.Branch
+		s["vcs_repo_path"] = ws.VCSRepo.Path
+		triggerPrefixes := ws.VCSRepo.TriggerPrefixes
+		if triggerPrefixes == nil {
+			triggerPrefixes = make([]string, 0)
+		}
+		encoded, _ := json.Marshal(triggerPrefixes)
+		s["vcs_repo_trigger_prefixes"] = string(encoded)
+		s["vcs_repo_dry_runs_enabled"] = strconv.FormatBool(ws.VCSRepo.DryRunsEnabled)
+	}
+	if ws.Hooks != nil {
+		s["hooks_pre_plan"] = ws.Hooks.PrePlan
+		s["hooks_post_plan"] = ws.Hooks.PostPlan
+		s["hooks_pre_apply"] = ws.Hooks.PreApply
+		s["hooks_post_apply"] = ws.Hooks.PostApply
+	}
+	return s
+}
+
+// getWorkspaceTemplateOverrides returns the settings overridden in the configuration.
+func getWorkspaceTemplateOverrides(d *schema.ResourceData) map[string]string {
+	overrides := make(map[string]string)
+	if v, ok := d.GetOkExists("auto_apply"); ok {
+		overrides["auto_apply"] = strconv.FormatBool(v.(bool))
+	}
+	for _, key := range []string{"terraform_version", "working_directory", "agent_pool_id"} {
+		if v, ok := d.GetOk(key); ok {
+			overrides[key] = v.(string)
+		}
+	}
+	return overrides
+}
+
+// workspaceUpdateOptionsFromSettings builds the update options that apply the settings.
+func workspaceUpdateOptionsFromSettings(s map[string]string) (scalr.WorkspaceUpdateOptions, error) {
+	options := scalr.WorkspaceUpdateOptions{
+		AutoApply:        scalr.Bool(s["auto_apply"] == "true"),
+		Operations:       scalr.Bool(s["operations"] == "true"),
+		WorkingDirectory: scalr.String(s["working_directory"]),
+		Hooks: &scalr.HooksOptions{
+			PrePlan:   scalr.String(s["hooks_pre_plan"]),
+			PostPlan:  scalr.String(s["hooks_post_plan"]),
+			PreApply:  scalr.String(s["hooks_pre_apply"]),
+			PostApply: scalr.String(s["hooks_post_apply"]),
+		},
+	}
+
+	if v := s["terraform_version"]; v != "" {
+		options.TerraformVersion = scalr.String(v)
+	}
+	if v := s["agent_pool_id"]; v != "" {
+		options.AgentPool = &scalr.AgentPool{ID: v}
+	}
+	if v := s["module_version_id"]; v != "" {
+		options.ModuleVersion = &scalr.ModuleVersion{ID: v}
+	}
+	if v := s["vcs_provider_id"]; v != "" {
+		options.VcsProvider = &scalr.VcsProvider{ID: v}
+	}
+	if v := s["vcs_repo_identifier"]; v != "" {
+		triggerPrefixes := make([]string, 0)
+		if p := s["vcs_repo_trigger_prefixes"]; p != "" {
+			if err := json.Unmarshal([]byte(p), &triggerPrefixes); err != nil {
+				return options, fmt.Errorf("Error decoding trigger prefixes %q: %v", p, err)
+			}
+		}
+		options.VCSRepo = &scalr.WorkspaceVCSRepoOptions{
+			Identifier:      scalr.String(v),
+			Branch:          scalr.String(s["vcs_repo_branch"]),
+			Path:            scalr.String(s["vcs_repo_path"]),
+			TriggerPrefixes: &triggerPrefixes,
+			DryRunsEnabled:  scalr.Bool(s["vcs_repo_dry_runs_enabled"] == "true"),
+		}
+	}
+
+	return options, nil
+}
+
+// getWorkspaceTemplate reads the source workspace and returns its settings.
+func getWorkspaceTemplate(scalrClient *scalr.Client, sourceID string) (*scalr.Workspace, map[string]string, error) {
+	source, err := scalrClient.Workspaces.ReadByID(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return nil, nil, fmt.Errorf("Could not find source workspace %s", sourceID)
+		}
+		return nil, nil, fmt.Errorf("Error retrieving source workspace %s: %v", sourceID, err)
+	}
+
+	return source, workspaceTemplateSettings(source), nil
+}
+
+// resourceScalrWorkspaceFromTemplateCustomizeDiff compares the template recorded in the state
+// with the current settings of the source workspace, so changes of the template show up as a diff.
+func resourceScalrWorkspaceFromTemplateCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("source_workspace_id") {
+		return nil
+	}
+
+	_, template, err := getWorkspaceTemplate(meta.(*scalr.Client), d.Get("source_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	old := d.Get("template").(map[string]interface{})
+	changed := len(old) != len(template)
+	for key, value := range template {
+		if old[key] != value {
+			changed = true
+			break
+		}
+	}
+	if changed {
+		log.Printf("[DEBUG] Template of workspace %s has changed", d.Id())
+		if err := d.SetNew("template", template); err != nil {
+			return err
+		}
+
+		// The VCS repo can't be removed from a workspace by the API client,
+		// so the workspace is recreated to follow the template.
+		if old["vcs_repo_identifier"] != nil && template["vcs_repo_identifier"] == "" {
+			log.Printf("[DEBUG] Template of workspace %s has no VCS repo anymore", d.Id())
+			return d.ForceNew("template")
+		}
+	}
+
+	return nil
+}
+
+func resourceScalrWorkspaceFromTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	sourceID := d.Get("source_workspace_id").(string)
+	source, template, err := getWorkspaceTemplate(scalrClient, sourceID)
+	if err != nil {
+		return err
+	}
+
+	environmentID := source.Environment.ID
+	if v, ok := d.GetOk("environment_id"); ok {
+		environmentID = v.(string)
+	}
+
+	settings := make(map[string]string, len(template))
+	for key, value := range template {
+		settings[key] = value
+	}
+	for key, value := range getWorkspaceTemplateOverrides(d) {
+		settings[key] = value
+	}
+
+	update, err := workspaceUpdateOptionsFromSettings(settings)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	options := scalr.WorkspaceCreateOptions{
+		Name:             scalr.String(name),
+		Environment:      &scalr.Environment{ID: environmentID},
+		AutoApply:        update.AutoApply,
+		Operations:       update.Operations,
+		TerraformVersion: update.TerraformVersion,
+		WorkingDirectory: update.WorkingDirectory,
+		Hooks:            update.Hooks,
+		VCSRepo:          update.VCSRepo,
+		VcsProvider:      update.VcsProvider,
+		AgentPool:        update.AgentPool,
+		ModuleVersion:    update.ModuleVersion,
+	}
+
+	log.Printf("[DEBUG] Create workspace %s from template %s", name, sourceID)
+	workspace, err := scalrClient.Workspaces.Create(ctx, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating workspace %s from template %s: %v", name, sourceID, err)
+	}
+	d.SetId(workspace.ID)
+	d.Set("template", template)
+
+	return resourceScalrWorkspaceFromTemplateRead(d, meta)
+}
+
+func resourceScalrWorkspaceFromTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Read configuration of workspace: %s", id)
+	workspace, err := scalrClient.Workspaces.ReadByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of workspace %s: %v", id, err)
+	}
+
+	d.Set("name", workspace.Name)
+	d.Set("environment_id", workspace.Environment.ID)
+
+	// The inherited settings are recorded as the workspace has them, so both
+	// a change of the template and a change of the workspace are shown as drift.
+	overrides := getWorkspaceTemplateOverrides(d)
+	actual := workspaceTemplateSettings(workspace)
+	template := d.Get("template").(map[string]interface{})
+	inherited := make([]string, 0)
+	for key, value := range actual {
+		if _, ok := overrides[key]; ok {
+			continue
+		}
+		template[key] = value
+		inherited = append(inherited, key)
+	}
+	sort.Strings(inherited)
+
+	d.Set("template", template)
+	d.Set("inherited", inherited)
+
+	return nil
+}
+
+func resourceScalrWorkspaceFromTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+	id := d.Id()
+
+	if d.HasChange("name") || d.HasChange("template") || d.HasChange("auto_apply") ||
+		d.HasChange("terraform_version") || d.HasChange("working_directory") ||
+		d.HasChange("agent_pool_id") {
+		settings := make(map[string]string)
+		for key, value := range d.Get("template").(map[string]interface{}) {
+			settings[key] = value.(string)
+		}
+		for key, value := range getWorkspaceTemplateOverrides(d) {
+			settings[key] = value
+		}
+
+		options, err := workspaceUpdateOptionsFromSettings(settings)
+		if err != nil {
+			return err
+		}
+		options.Name = scalr.String(d.Get("name").(string))
+
+		log.Printf("[DEBUG] Update workspace %s from template", id)
+		_, err = scalrClient.Workspaces.Update(ctx, id, options)
+		if err != nil {
+			return fmt.Errorf("Error updating workspace %s: %v", id, err)
+		}
+	}
+
+	return resourceScalrWorkspaceFromTemplateRead(d, meta)
+}
@@ -0,0 +1,168 @@
+package scalr
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	scalr "github.com/scalr/go-scalr"
+)
+
+func TestWorkspaceTemplateSettings(t *testing.T) {
+	ws := &scalr.Workspace{
+		AutoApply:        true,
+		Operations:       true,
+		TerraformVersion: "1.1.7",
+		WorkingDirectory: "/infra",
+		AgentPool:        &scalr.AgentPool{ID: "apool-123"},
+		VcsProvider:      &scalr.VcsProvider{ID: "vcs-123"},
+		VCSRepo: &scalr.WorkspaceVCSRepo{
+			Identifier:      "org/repo",
+			Branch:          "main",
+			TriggerPrefixes: []string{"modules", "infra/a,b"},
+			DryRunsEnabled:  true,
+		},
+		Hooks: &scalr.Hooks{PrePlan: "./pre-plan.sh"},
+	}
+
+	settings := workspaceTemplateSettings(ws)
+	if settings["vcs_repo_trigger_prefixes"] != `["modules","infra/a,b"]` {
+		t.Errorf("unexpected trigger prefixes setting: %s", settings["vcs_repo_trigger_prefixes"])
+	}
+	options, err := workspaceUpdateOptionsFromSettings(settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !*options.AutoApply || !*options.Operations {
+		t.Errorf("expected auto_apply and operations to be copied, got %v and %v", *options.AutoApply, *options.Operations)
+	}
+	if *options.TerraformVersion != "1.1.7" || *options.WorkingDirectory != "/infra" {
+		t.Errorf("unexpected terraform_version %q or working_directory %q", *options.TerraformVersion, *options.WorkingDirectory)
+	}
+	if options.AgentPool.ID != "apool-123" || options.VcsProvider.ID != "vcs-123" || options.ModuleVersion != nil {
+		t.Errorf("unexpected relations: %v %v %v", options.AgentPool, options.VcsProvider, options.ModuleVersion)
+	}
+	if *options.VCSRepo.Identifier != "org/repo" || *options.VCSRepo.Branch != "main" || !*options.VCSRepo.DryRunsEnabled {
+		t.Errorf("unexpected vcs_repo: %+v", options.VCSRepo)
+	}
+	if !reflect.DeepEqual(*options.VCSRepo.TriggerPrefixes, []string{"modules", "infra/a,b"}) {
+		t.Errorf("unexpected trigger prefixes: %v", *options.VCSRepo.TriggerPrefixes)
+	}
+	if *options.Hooks.PrePlan != "./pre-plan.sh" || *options.Hooks.PostApply != "" {
+		t.Errorf("unexpected hooks: %+v", options.Hooks)
+	}
+
+	// A workspace without VCS repo and agent pool.
+	options, err = workspaceUpdateOptionsFromSettings(workspaceTemplateSettings(&scalr.Workspace{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if options.VCSRepo != nil || options.AgentPool != nil || options.TerraformVersion != nil {
+		t.Errorf("expected empty settings, got %+v", options)
+	}
+}
+
+func TestAccScalrWorkspaceFromTemplate_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckScalrWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceFromTemplateConfig(rInt, "./pre-plan.sh"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_from_template.test", "environment_id",
+						"scalr_environment.test", "id",
+					),
+					resource.TestCheckResourceAttr("scalr_workspace_from_template.test", "template.hooks_pre_plan", "./pre-plan.sh"),
+					resource.TestCheckResourceAttr("scalr_workspace_from_template.test", "template.auto_apply", "true"),
+					resource.TestCheckResourceAttr("scalr_workspace_from_template.test", "template.working_directory", "/app"),
+				),
+			},
+			{
+				// The template is read before it is updated,
+				// so the change shows up as drift on the next plan.
+				Config:             testAccScalrWorkspaceFromTemplateConfig(rInt, "./pre-plan-v2.sh"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccScalrWorkspaceFromTemplateConfig(rInt, "./pre-plan-v2.sh"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_from_template.test", "template.hooks_pre_plan", "./pre-plan-v2.sh"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrWorkspaceFromTemplateConfig(rInt int, prePlan string) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource scalr_workspace template {
+  name              = "workspace-template"
+  environment_id    = scalr_environment.test.id
+  auto_apply        = true
+  working_directory = "/app"
+  hooks {
+    pre_plan = "%s"
+  }
+}
+
+resource scalr_workspace_from_template test {
+  source_workspace_id = scalr_workspace.template.id
+  name                = "workspace-from-template"
+  working_directory   = "/infra"
+}`, rInt, defaultAccount, prePlan)
+}
+
+func TestResourceScalrWorkspaceFromTemplateCustomizeDiff_vcsRepoRemoved(t *testing.T) {
+	client := testScalrClient(t)
+	client.Workspaces.Create(context.Background(), scalr.WorkspaceCreateOptions{
+		ID:          "ws-template",
+		Name:        scalr.String("template"),
+		Environment: &scalr.Environment{ID: "env-123"},
+	})
+
+	// The template had a VCS repo when the workspace was created from it.
+	template := workspaceTemplateSettings(&scalr.Workspace{
+		VCSRepo: &scalr.WorkspaceVCSRepo{Identifier: "org/repo", Branch: "main"},
+	})
+	attributes := map[string]string{
+		"id":                  "ws-123",
+		"source_workspace_id": "ws-template",
+		"name":                "clone",
+		"environment_id":      "env-123",
+		"template.%":          strconv.Itoa(len(template)),
+	}
+	for key, value := range template {
+		attributes["template."+key] = value
+	}
+
+	r := resourceScalrWorkspaceFromTemplate()
+	state := &terraform.InstanceState{ID: "ws-123", Attributes: attributes}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"source_workspace_id": "ws-template",
+		"name":                "clone",
+		"environment_id":      "env-123",
+	})
+
+	diff, err := r.Diff(state, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected the workspace to be replaced, got %v", diff)
+	}
+}
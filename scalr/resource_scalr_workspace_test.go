@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -251,6 +252,136 @@ func TestAccScalrWorkspace_import(t *testing.T) {
 	})
 }
 
+func TestAccScalrWorkspace_moduleVersionConstraint(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testVcsAccGithubTokenPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckScalrWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAccountModule(rInt),
+			},
+			{
+				PreConfig: waitForModuleVersions(fmt.Sprintf("test-env-%d", rInt)),
+				Config:    testAccScalrWorkspaceModuleVersionConstraint(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace.test", "resolved_module_version_id",
+						"data.scalr_module_version.latest", "id",
+					),
+					resource.TestCheckResourceAttr("scalr_workspace.test", "module_version_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressHookWhitespaceDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"./post-plan.sh", "./post-plan.sh\n", true},
+		{"./post-plan.sh", "  ./post-plan.sh  ", true},
+		{"", "\n", true},
+		{"./post-plan.sh", "./post-plan-v2.sh", false},
+		{"./a.sh && ./b.sh", "./a.sh &&  ./b.sh", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressHookWhitespaceDiff("hooks.0.post_plan", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("%q -> %q: expected %t, got %t", c.old, c.new, c.suppress, got)
+		}
+	}
+}
+
+func TestResourceScalrWorkspaceDiff_agentPool(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "ws-123",
+		Attributes: map[string]string{
+			"id":             "ws-123",
+			"name":           "workspace",
+			"environment_id": "env-123",
+			"agent_pool_id":  "apool-123",
+		},
+	}
+
+	cases := map[string]struct {
+		config   map[string]interface{}
+		expected string
+	}{
+		"removed": {
+			config:   map[string]interface{}{},
+			expected: "",
+		},
+		"empty": {
+			config:   map[string]interface{}{"agent_pool_id": ""},
+			expected: "",
+		},
+		"changed": {
+			config:   map[string]interface{}{"agent_pool_id": "apool-456"},
+			expected: "apool-456",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":           "workspace",
+				"environment_id": "env-123",
+			}
+			for k, v := range tc.config {
+				raw[k] = v
+			}
+
+			diff, err := resourceScalrWorkspace().Diff(state, terraform.NewResourceConfigRaw(raw), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff == nil || diff.Attributes["agent_pool_id"] == nil {
+				t.Fatalf("expected a diff of agent_pool_id, got %v", diff)
+			}
+			if got := diff.Attributes["agent_pool_id"].New; got != tc.expected {
+				t.Fatalf("expected agent_pool_id %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateTerraformVersion(t *testing.T) {
+	cases := map[string]string{
+		"1.1.7":       "",
+		"0.15.5":      "",
+		"1.2.0-beta1": "",
+		"1.1":         "must be a full version",
+		"1":           "must be a full version",
+		"v1.1.7":      "must be a full version",
+		"1.1.7.1":     "must be a full version",
+		"1.O.7":       "must be a valid version",
+		"latest":      "must be a valid version",
+		"~> 1.5":      "version constraints are not supported",
+		">= 1.0, < 2": "version constraints are not supported",
+	}
+
+	for v, expected := range cases {
+		_, errs := validateTerraformVersion(v, "terraform_version")
+		if expected == "" {
+			if len(errs) != 0 {
+				t.Errorf("%q: unexpected errors: %v", v, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), expected) {
+			t.Errorf("%q: expected error containing %q, got %v", v, expected, errs)
+		}
+	}
+}
+
 func testAccCheckScalrWorkspaceExists(
 	n string, workspace *scalr.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -429,6 +560,25 @@ resource scalr_workspace test {
 }`)
 }
 
+func testAccScalrWorkspaceModuleVersionConstraint(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "scalr_module_version" "latest" {
+  source = scalr_module.test.source
+}
+
+resource scalr_workspace test {
+  name           = "workspace-module-test"
+  environment_id = scalr_environment.test.id
+
+  module_version_constraint {
+    source  = scalr_module.test.source
+    version = ">= 0.0.1"
+  }
+}`, testAccScalrAccountModule(rInt))
+}
+
 func testAccScalrWorkspaceMonorepo(rInt int) string {
 	return fmt.Sprintf(testAccScalrWorkspaceCommonConfig, rInt, defaultAccount, `
 resource "scalr_workspace" "test" {
@@ -0,0 +1,341 @@
+package scalr
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	scalr "github.com/scalr/go-scalr"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func resourceScalrWorkspaceTfvars() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceScalrWorkspaceTfvarsCreate,
+		Read:          resourceScalrWorkspaceTfvarsRead,
+		Update:        resourceScalrWorkspaceTfvarsUpdate,
+		Delete:        resourceScalrWorkspaceTfvarsDelete,
+		CustomizeDiff: resourceScalrWorkspaceTfvarsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"path", "content"},
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"path", "content"},
+			},
+			"sensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sensitive_variables": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"hcl_variables": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"variable_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// tfvar is a single variable of a tfvars file. Lists, maps and objects
+// are rendered as HCL, so they keep their type in the workspace.
+type tfvar struct {
+	value string
+	hcl   bool
+}
+
+// parseTfvars parses the tfvars file. JSON is expected
+// if the file name ends with .json or the content is a JSON object.
+func parseTfvars(filename string, src []byte) (map[string]tfvar, error) {
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") || strings.HasPrefix(strings.TrimSpace(string(src)), "{") {
+		file, diags = hcljson.Parse(src, filename)
+	} else {
+		file, diags = hclsyntax.ParseConfig(src, filename, hcl.Pos{Line: 1, Column: 1})
+	}
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("Error parsing %s: %v", filename, diags)
+	}
+
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("Error parsing %s: %v", filename, diags)
+	}
+
+	vars := make(map[string]tfvar, len(attrs))
+	for name, attr := range attrs {
+		v, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("Error parsing %s: %v", filename, diags)
+		}
+		if v.IsNull() {
+			return nil, fmt.Errorf("Error parsing %s: variable %s is null", filename, name)
+		}
+
+		switch ty := v.Type(); {
+		case ty == cty.String:
+			vars[name] = tfvar{value: v.AsString()}
+		case ty == cty.Number:
+			vars[name] = tfvar{value: v.AsBigFloat().Text('f', -1)}
+		case ty == cty.Bool:
+			vars[name] = tfvar{value: fmt.Sprintf("%t", v.True())}
+		default:
+			vars[name] = tfvar{value: string(hclwrite.TokensForValue(v).Bytes()), hcl: true}
+		}
+	}
+
+	return vars, nil
+}
+
+// tfvarsValuesKey returns the attribute that holds the values of the variables,
+// so the values are hidden from the plan only if the variables are sensitive.
+func tfvarsValuesKey(sensitive bool) string {
+	if sensitive {
+		return "sensitive_variables"
+	}
+	return "variables"
+}
+
+// getTfvars returns the variables of the configured file or content.
+func getTfvars(d interface{ Get(string) interface{} }) (map[string]tfvar, error) {
+	if path := d.Get("path").(string); path != "" {
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading tfvars file: %v", err)
+		}
+		return parseTfvars(path, src)
+	}
+
+	return parseTfvars("content", []byte(d.Get("content").(string)))
+}
+
+// resourceScalrWorkspaceTfvarsCustomizeDiff parses the file at plan time,
+// so changes to the file show up as a diff of the variables.
+func resourceScalrWorkspaceTfvarsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	key := tfvarsValuesKey(d.Get("sensitive").(bool))
+
+	if !d.NewValueKnown("path") || !d.NewValueKnown("content") {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+		return d.SetNewComputed("hcl_variables")
+	}
+
+	vars, err := getTfvars(d)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]interface{}, len(vars))
+	hclNames := make([]interface{}, 0)
+	for name, v := range vars {
+		values[name] = v.value
+		if v.hcl {
+			hclNames = append(hclNames, name)
+		}
+	}
+
+	if err := d.SetNew(key, values); err != nil {
+		return err
+	}
+	return d.SetNew("hcl_variables", hclNames)
+}
+
+func resourceScalrWorkspaceTfvarsCreate(d *schema.ResourceData, meta interface{}) error {
+	workspaceID := d.Get("workspace_id").(string)
+
+	// The workspace can only have one set of the variables.
+	d.SetId(workspaceID)
+	d.Set("variable_ids", map[string]interface{}{})
+
+	if err := syncWorkspaceTfvars(d, meta.(*scalr.Client)); err != nil {
+		return err
+	}
+
+	return resourceScalrWorkspaceTfvarsRead(d, meta)
+}
+
+func resourceScalrWorkspaceTfvarsRead(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Read tfvars of workspace: %s", id)
+	_, err := scalrClient.Workspaces.ReadByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound{}) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving workspace %s: %v", id, err)
+	}
+
+	key := tfvarsValuesKey(d.Get("sensitive").(bool))
+	values := d.Get(key).(map[string]interface{})
+	ids := make(map[string]interface{})
+	newValues := make(map[string]interface{})
+	hclNames := make([]interface{}, 0)
+	for name, varID := range d.Get("variable_ids").(map[string]interface{}) {
+		variable, err := scalrClient.Variables.Read(ctx, varID.(string))
+		if err != nil {
+			if errors.Is(err, scalr.ErrResourceNotFound{}) {
+				log.Printf("[DEBUG] Variable %s of workspace %s no longer exists", name, id)
+				continue
+			}
+			return fmt.Errorf("Error reading variable %s: %v", varID, err)
+		}
+
+		ids[name] = variable.ID
+		if variable.HCL {
+			hclNames = append(hclNames, name)
+		}
+		// Only use the value if it's not sensitive, as otherwise it will be empty.
+		if variable.Sensitive {
+			newValues[name] = values[name]
+		} else {
+			newValues[name] = variable.Value
+		}
+	}
+
+	d.Set("workspace_id", id)
+	d.Set("variable_ids", ids)
+	d.Set(key, newValues)
+	d.Set("hcl_variables", hclNames)
+
+	return nil
+}
+
+func resourceScalrWorkspaceTfvarsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := syncWorkspaceTfvars(d, meta.(*scalr.Client)); err != nil {
+		return err
+	}
+
+	return resourceScalrWorkspaceTfvarsRead(d, meta)
+}
+
+func resourceScalrWorkspaceTfvarsDelete(d *schema.ResourceData, meta interface{}) error {
+	scalrClient := meta.(*scalr.Client)
+
+	for name, varID := range d.Get("variable_ids").(map[string]interface{}) {
+		log.Printf("[DEBUG] Delete variable %s of workspace %s", name, d.Id())
+		err := scalrClient.Variables.Delete(ctx, varID.(string))
+		if err != nil && !errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return fmt.Errorf("Error deleting variable %s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// syncWorkspaceTfvars creates, updates and deletes the variables of the workspace
+// to match the file. The IDs are saved as it goes, so the variables created before
+// a failure are not lost.
+func syncWorkspaceTfvars(d *schema.ResourceData, scalrClient *scalr.Client) error {
+	workspaceID := d.Get("workspace_id").(string)
+	sensitive := d.Get("sensitive").(bool)
+
+	vars, err := getTfvars(d)
+	if err != nil {
+		return err
+	}
+
+	oldValues, _ := d.GetChange(tfvarsValuesKey(sensitive))
+	oldHCL, _ := d.GetChange("hcl_variables")
+	ids := d.Get("variable_ids").(map[string]interface{})
+	defer d.Set("variable_ids", ids)
+
+	for name, varID := range ids {
+		if _, ok := vars[name]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete variable %s of workspace %s", name, workspaceID)
+		err := scalrClient.Variables.Delete(ctx, varID.(string))
+		if err != nil && !errors.Is(err, scalr.ErrResourceNotFound{}) {
+			return fmt.Errorf("Error deleting variable %s: %v", name, err)
+		}
+		delete(ids, name)
+	}
+
+	// Sort the names to create the variables in a stable order.
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := vars[name]
+
+		if varID, ok := ids[name]; ok {
+			if oldValues.(map[string]interface{})[name] == v.value &&
+				oldHCL.(*schema.Set).Contains(name) == v.hcl {
+				continue
+			}
+
+			log.Printf("[DEBUG] Update variable %s of workspace %s", name, workspaceID)
+			_, err := scalrClient.Variables.Update(ctx, varID.(string), scalr.VariableUpdateOptions{
+				Value: scalr.String(v.value),
+				HCL:   scalr.Bool(v.hcl),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating variable %s: %v", name, err)
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Create variable %s of workspace %s", name, workspaceID)
+		variable, err := scalrClient.Variables.Create(ctx, scalr.VariableCreateOptions{
+			Key:         scalr.String(name),
+			Value:       scalr.String(v.value),
+			Description: scalr.String(""),
+			Category:    scalr.Category(scalr.CategoryTerraform),
+			HCL:         scalr.Bool(v.hcl),
+			Sensitive:   scalr.Bool(sensitive),
+			Workspace:   &scalr.Workspace{ID: workspaceID},
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating variable %s: %v", name, err)
+		}
+		ids[name] = variable.ID
+	}
+
+	return nil
+}
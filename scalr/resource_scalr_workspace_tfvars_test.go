@@ -0,0 +1,170 @@
+package scalr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestParseTfvars(t *testing.T) {
+	expected := map[string]tfvar{
+		"region":   {value: "us-east-1"},
+		"replicas": {value: "3"},
+		"ratio":    {value: "0.5"},
+		"enabled":  {value: "true"},
+		"zones":    {value: `["a", "b"]`, hcl: true},
+		"tags":     {value: "{\n  team = \"core\"\n}", hcl: true},
+	}
+
+	hclSrc := `
+region   = "us-east-1"
+replicas = 3
+ratio    = 0.5
+enabled  = true
+zones    = ["a", "b"]
+tags     = { team = "core" }
+`
+	jsonSrc := `{
+  "region": "us-east-1",
+  "replicas": 3,
+  "ratio": 0.5,
+  "enabled": true,
+  "zones": ["a", "b"],
+  "tags": {"team": "core"}
+}`
+
+	for filename, src := range map[string]string{
+		"prod.tfvars":      hclSrc,
+		"prod.tfvars.json": jsonSrc,
+		"content":          jsonSrc,
+	} {
+		vars, err := parseTfvars(filename, []byte(src))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", filename, err)
+		}
+		if !reflect.DeepEqual(vars, expected) {
+			t.Errorf("%s: expected %v, got %v", filename, expected, vars)
+		}
+	}
+}
+
+func TestParseTfvars_errors(t *testing.T) {
+	cases := map[string]string{
+		"region = var.region": "Variables not allowed",
+		"region = null":       "variable region is null",
+		"region = ":           "Error parsing content",
+		"tags { a = 1 }":      "Unexpected \"tags\" block",
+	}
+
+	for src, expected := range cases {
+		_, err := parseTfvars("content", []byte(src))
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%q: expected error containing %q, got %v", src, expected, err)
+		}
+	}
+}
+
+func TestAccScalrWorkspaceTfvars_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceTfvarsConfig(rInt, `
+region = "us-east-1"
+zones  = ["a", "b"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variable_ids.%", "2"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variables.region", "us-east-1"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variables.zones", `["a", "b"]`),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "hcl_variables.#", "1"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "sensitive_variables.%", "0"),
+				),
+			},
+			{
+				Config: testAccScalrWorkspaceTfvarsConfig(rInt, `
+region = "eu-west-1"
+zones  = "[\"a\", \"b\"]"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variable_ids.%", "2"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variables.region", "eu-west-1"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variables.zones", `["a", "b"]`),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "hcl_variables.#", "0"),
+				),
+			},
+			{
+				Config: testAccScalrWorkspaceTfvarsConfig(rInt, `
+region = "eu-west-1"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variable_ids.%", "1"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variables.region", "eu-west-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScalrWorkspaceTfvars_sensitive(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceTfvarsSensitiveConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variable_ids.%", "1"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "variables.%", "0"),
+					resource.TestCheckResourceAttr("scalr_workspace_tfvars.test", "sensitive_variables.password", "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrWorkspaceTfvarsConfig(rInt int, content string) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource scalr_workspace test {
+  name           = "workspace-test"
+  environment_id = scalr_environment.test.id
+}
+
+resource scalr_workspace_tfvars test {
+  workspace_id = scalr_workspace.test.id
+  content      = <<EOT
+%sEOT
+}`, rInt, defaultAccount, content)
+}
+
+func testAccScalrWorkspaceTfvarsSensitiveConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource scalr_workspace test {
+  name           = "workspace-test"
+  environment_id = scalr_environment.test.id
+}
+
+resource scalr_workspace_tfvars test {
+  workspace_id = scalr_workspace.test.id
+  sensitive    = true
+  content      = "password = \"secret\""
+}`, rInt, defaultAccount)
+}
@@ -27,6 +27,8 @@ func testScalrClient(t *testing.T) *scalr.Client {
 
 	client.Workspaces = newMockWorkspaces()
 	client.Variables = newMockVariables()
+	client.Environments = newMockEnvironments()
+	client.AccessPolicies = newMockAccessPolicies()
 
 	return client
 }
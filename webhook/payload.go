@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The events a webhook can be subscribed to.
+const (
+	EventRunCompleted      = "run:completed"
+	EventRunErrored        = "run:errored"
+	EventRunNeedsAttention = "run:needs_attention"
+)
+
+// Events is the list of the events this package can parse the payload of.
+var Events = []string{
+	EventRunCompleted,
+	EventRunErrored,
+	EventRunNeedsAttention,
+}
+
+// Delivery is the body of a webhook request.
+type Delivery struct {
+	EventName   string          `json:"event-name"`
+	WebhookID   string          `json:"webhook-id"`
+	WebhookName string          `json:"webhook-name"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// Reference identifies a Scalr object related to the event.
+type Reference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// RunPayload is the payload of the run:* events.
+type RunPayload struct {
+	Run struct {
+		ID        string `json:"id"`
+		Status    string `json:"status"`
+		Source    string `json:"source"`
+		Message   string `json:"message"`
+		IsDestroy bool   `json:"is-destroy"`
+		IsDryRun  bool   `json:"is-dry"`
+		CreatedAt string `json:"created-at"`
+	} `json:"run"`
+	Workspace   Reference `json:"workspace"`
+	Environment Reference `json:"environment"`
+	Account     Reference `json:"account"`
+}
+
+// Parse decodes the body of a webhook request.
+func Parse(body []byte) (*Delivery, error) {
+	d := &Delivery{}
+	if err := json.Unmarshal(body, d); err != nil {
+		return nil, fmt.Errorf("error decoding webhook delivery: %v", err)
+	}
+	if d.EventName == "" {
+		return nil, fmt.Errorf("error decoding webhook delivery: missing event name")
+	}
+	return d, nil
+}
+
+// Run decodes the payload of a run:* event.
+func (d *Delivery) Run() (*RunPayload, error) {
+	switch d.EventName {
+	case EventRunCompleted, EventRunErrored, EventRunNeedsAttention:
+	default:
+		return nil, fmt.Errorf("event %s has no run payload", d.EventName)
+	}
+
+	p := &RunPayload{}
+	if err := json.Unmarshal(d.Payload, p); err != nil {
+		return nil, fmt.Errorf("error decoding %s payload: %v", d.EventName, err)
+	}
+	return p, nil
+}
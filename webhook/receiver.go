@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Receiver is an http.Handler that accepts the deliveries signed with
+// the secret key, and records them. It is meant to be served with
+// net/http/httptest in the tests of the webhook consumers.
+type Receiver struct {
+	secret string
+
+	mu         sync.Mutex
+	deliveries []*Delivery
+	rejected   int
+}
+
+// NewReceiver returns a receiver for the endpoint with the secret key.
+func NewReceiver(secret string) *Receiver {
+	return &Receiver{secret: secret}
+}
+
+// ServeHTTP responds with 401 to the deliveries with a wrong signature,
+// with 400 to the ones it cannot parse, and with 204 otherwise.
+func (rc *Receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := VerifyRequest(r, rc.secret)
+	if err != nil {
+		rc.reject()
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	d, err := Parse(body)
+	if err != nil {
+		rc.reject()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rc.mu.Lock()
+	rc.deliveries = append(rc.deliveries, d)
+	rc.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Deliveries returns the accepted deliveries in the order they were received.
+func (rc *Receiver) Deliveries() []*Delivery {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]*Delivery(nil), rc.deliveries...)
+}
+
+// Rejected returns the number of the rejected deliveries.
+func (rc *Receiver) Rejected() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.rejected
+}
+
+func (rc *Receiver) reject() {
+	rc.mu.Lock()
+	rc.rejected++
+	rc.mu.Unlock()
+}
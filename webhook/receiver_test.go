@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// deliver sends the body to the receiver the way Scalr does.
+func deliver(t *testing.T, url, secret string, body []byte) int {
+	t.Helper()
+
+	date := time.Now().UTC().Format(http.TimeFormat)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DateHeader, date)
+	req.Header.Set(SignatureHeader, Sign(secret, body, date))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error delivering webhook: %v", err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestReceiver(t *testing.T) {
+	receiver := NewReceiver(testSecret)
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	for _, event := range Events {
+		body := []byte(fmt.Sprintf(`{
+  "event-name": "%s",
+  "webhook-id": "wh-123",
+  "webhook-name": "test",
+  "payload": {
+    "run": {"id": "run-123", "status": "applied", "source": "vcs"},
+    "workspace": {"id": "ws-123", "name": "a-workspace"},
+    "environment": {"id": "env-123", "name": "an-environment"},
+    "account": {"id": "acc-123", "name": "an-account"}
+  }
+}`, event))
+		if code := deliver(t, server.URL, testSecret, body); code != http.StatusNoContent {
+			t.Fatalf("%s: expected status %d, got %d", event, http.StatusNoContent, code)
+		}
+	}
+
+	if code := deliver(t, server.URL, "another-secret", []byte(`{"event-name":"run:completed"}`)); code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d for a wrong signature, got %d", http.StatusUnauthorized, code)
+	}
+	if code := deliver(t, server.URL, testSecret, []byte(`not-a-json`)); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for a malformed payload, got %d", http.StatusBadRequest, code)
+	}
+
+	deliveries := receiver.Deliveries()
+	if len(deliveries) != len(Events) {
+		t.Fatalf("expected %d deliveries, got %d", len(Events), len(deliveries))
+	}
+	if receiver.Rejected() != 2 {
+		t.Fatalf("expected 2 rejected deliveries, got %d", receiver.Rejected())
+	}
+
+	for i, d := range deliveries {
+		if d.EventName != Events[i] {
+			t.Fatalf("expected event %s, got %s", Events[i], d.EventName)
+		}
+		run, err := d.Run()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", d.EventName, err)
+		}
+		if run.Run.ID != "run-123" || run.Workspace.ID != "ws-123" || run.Account.ID != "acc-123" {
+			t.Fatalf("%s: wrong payload: %#v", d.EventName, run)
+		}
+	}
+}
+
+func TestDeliveryRunUnknownEvent(t *testing.T) {
+	d, err := Parse([]byte(`{"event-name":"workspace:created","payload":{}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := d.Run(); err == nil {
+		t.Fatal("expected error for an event without run payload")
+	}
+}
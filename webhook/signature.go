@@ -0,0 +1,67 @@
+// Package webhook helps to consume the webhooks delivered by Scalr.
+//
+// Scalr signs every delivery with the secret key of the endpoint: the
+// X-Signature header holds the hex-encoded HMAC-SHA512 of the request body
+// followed by the value of the Date header.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	// SignatureHeader is the header holding the signature of the delivery.
+	SignatureHeader = "X-Signature"
+	// DateHeader is the header holding the date the delivery was signed at.
+	DateHeader = "Date"
+)
+
+var (
+	// ErrMissingSignature is returned when the delivery is not signed.
+	ErrMissingSignature = errors.New("missing webhook signature")
+	// ErrInvalidSignature is returned when the signature does not match the payload.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Sign returns the signature Scalr computes for the given body and date.
+func Sign(secret string, body []byte, date string) string {
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(body)
+	mac.Write([]byte(date))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that the signature matches the body and the date.
+func Verify(secret string, body []byte, date, signature string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	expected := Sign(secret, body, date)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyRequest checks the signature of the delivery and returns its body.
+// The request body is restored, so it can be read again by the caller.
+func VerifyRequest(r *http.Request, secret string) ([]byte, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	err = Verify(secret, body, r.Header.Get(DateHeader), r.Header.Get(SignatureHeader))
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+)
+
+const testSecret = "strong_key_with_UPPERCASE_letter_at_least_1_number"
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"event-name":"run:completed"}`)
+	date := "Wed, 16 Feb 2022 10:00:00 GMT"
+	signature := Sign(testSecret, body, date)
+
+	cases := map[string]struct {
+		secret    string
+		body      []byte
+		date      string
+		signature string
+		err       error
+	}{
+		"valid signature": {
+			testSecret, body, date, signature, nil,
+		},
+		"missing signature": {
+			testSecret, body, date, "", ErrMissingSignature,
+		},
+		"wrong secret": {
+			"another-secret", body, date, signature, ErrInvalidSignature,
+		},
+		"tampered body": {
+			testSecret, []byte(`{"event-name":"run:errored"}`), date, signature, ErrInvalidSignature,
+		},
+		"tampered date": {
+			testSecret, body, "Thu, 17 Feb 2022 10:00:00 GMT", signature, ErrInvalidSignature,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Verify(tc.secret, tc.body, tc.date, tc.signature)
+			if err != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+// TestSign checks a fixed vector, computed the way Scalr's documentation
+// verifies deliveries: hmac.new(secret, body + date, sha512).hexdigest().
+func TestSign(t *testing.T) {
+	body := []byte(`{"event-name":"run:completed"}`)
+	date := "Wed, 16 Feb 2022 10:00:00 GMT"
+	expected := "39554b86ca29c6d008d39a86db79dcafec1feb2751d3cb8b3976385f5511dc47" +
+		"1166e98b549a4cad5cc4719121441ec94adff3cc2b4d2ee27edf98baaeb7147e"
+
+	if got := Sign(testSecret, body, date); got != expected {
+		t.Fatalf("wrong signature\ngot:  %s\nwant: %s", got, expected)
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	body := []byte(`{"event-name":"run:completed"}`)
+	date := "Wed, 16 Feb 2022 10:00:00 GMT"
+
+	r := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	r.Header.Set(DateHeader, date)
+	r.Header.Set(SignatureHeader, Sign(testSecret, body, date))
+
+	got, err := VerifyRequest(r, testSecret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, body) {
+		t.Fatalf("wrong body\ngot: %s\nwant: %s", got, body)
+	}
+
+	// The body must still be readable.
+	again, _ := ioutil.ReadAll(r.Body)
+	if !bytes.Equal(again, body) {
+		t.Fatalf("request body was not restored, got: %s", again)
+	}
+}